@@ -14,6 +14,7 @@ type Config struct {
 	Port                     string
 	ServerConfigPath         string
 	HighPrioServerConfigPath string
+	PoolsConfigPath          string
 }
 
 func initConfig() Config {
@@ -21,27 +22,21 @@ func initConfig() Config {
 	conf.Port = os.Getenv("EMAIL_CLIENT_SERVICE_LISTEN_PORT")
 	conf.ServerConfigPath = os.Getenv("MESSAGING_CONFIG_FOLDER") + "/smtp-servers.yaml"
 	conf.HighPrioServerConfigPath = os.Getenv("MESSAGING_CONFIG_FOLDER") + "/high-prio-smtp-servers.yaml"
+	conf.PoolsConfigPath = os.Getenv("MESSAGING_CONFIG_FOLDER") + "/smtp-pools.yaml"
 	return conf
 }
 
 func main() {
 	conf := initConfig()
 
-	smtpClients, err := sc.NewSmtpClients(conf.ServerConfigPath)
-	if err != nil {
-		logger.Error.Fatal(err)
-	}
-	highPrioSmtpClients, err := sc.NewSmtpClients(conf.HighPrioServerConfigPath)
-	if err != nil {
-		logger.Error.Fatal(err)
-	}
-
 	ctx := context.Background()
 	if err := email_client_service.RunServer(
 		ctx,
 		conf.Port,
-		highPrioSmtpClients,
-		smtpClients,
+		func() (*sc.SmtpPools, error) {
+			// without pools config, the two server lists of high and low prio emails are used
+			return sc.LoadSmtpPools(conf.PoolsConfigPath, conf.ServerConfigPath, conf.HighPrioServerConfigPath)
+		},
 	); err != nil {
 		logger.Error.Fatal(err)
 	}
@@ -2,21 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/coneno/logger"
 	"github.com/google/uuid"
 	"github.com/influenzanet/messaging-service/internal/config"
-	emailAPI "github.com/influenzanet/messaging-service/pkg/api/email_client_service"
 	"github.com/influenzanet/messaging-service/pkg/bulk_messages"
+	"github.com/influenzanet/messaging-service/pkg/channels"
 	"github.com/influenzanet/messaging-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
 	gc "github.com/influenzanet/messaging-service/pkg/grpc/clients"
+	"github.com/influenzanet/messaging-service/pkg/push_client"
+	"github.com/influenzanet/messaging-service/pkg/sms_client"
 	"github.com/influenzanet/messaging-service/pkg/types"
+	"github.com/influenzanet/messaging-service/pkg/webhook_client"
 )
 
 const (
@@ -27,11 +32,14 @@ const (
 type Config struct {
 	LogLevel    logger.LogLevel
 	Frequencies struct {
-		HighPrio                int
-		LowPrio                 int
+		OutgoingEmails          map[string]int // per priority
 		AutoMessage             int
 		ParticipantMessages     int
 		ResearcherNotifications int
+		OutgoingSMS             int
+		OutgoingPush            int
+		OutgoingWebhooks        int
+		NotificationDigests     int
 	}
 	MessageDBConfig types.DBConfig
 	GlobalDBConfig  types.DBConfig
@@ -40,19 +48,32 @@ type Config struct {
 		EmailClientService    string
 		StudyService          string
 	}
+	SMSProvider sms_client.SMSProviderConfig
+	VAPID       push_client.VAPIDConfig
+	Webhooks    webhook_client.WebhookClientConfig
+
+	// combine the pending participant messages of a user into one message
+	BatchParticipantMessages bool
 }
 
 func initConfig() Config {
 	conf := Config{}
 
-	hp, err := strconv.Atoi(os.Getenv("MESSAGE_SCHEDULER_INTERVAL_HIGH_PRIO"))
-	if err != nil {
-		logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_HIGH_PRIO: %v", err)
-	}
-
-	lp, err := strconv.Atoi(os.Getenv("MESSAGE_SCHEDULER_INTERVAL_LOW_PRIO"))
-	if err != nil {
-		logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_LOW_PRIO: %v", err)
+	// e.g. MESSAGE_SCHEDULER_INTERVAL_STUDY_CRITICAL, defaults to the interval of high or low prio emails
+	outgoingEmails := map[string]int{}
+	for _, priority := range types.PRIORITIES {
+		envName := "MESSAGE_SCHEDULER_INTERVAL_" + strings.ToUpper(strings.ReplaceAll(priority, "-", "_"))
+		if os.Getenv(envName) == "" {
+			envName = "MESSAGE_SCHEDULER_INTERVAL_LOW_PRIO"
+			if types.IsHighPriority(priority) {
+				envName = "MESSAGE_SCHEDULER_INTERVAL_HIGH_PRIO"
+			}
+		}
+		freq, err := strconv.Atoi(os.Getenv(envName))
+		if err != nil {
+			logger.Error.Fatalf("cannot parse %s: %v", envName, err)
+		}
+		outgoingEmails[priority] = freq
 	}
 
 	am, err := strconv.Atoi(os.Getenv("MESSAGE_SCHEDULER_INTERVAL_AUTO_MESSAGE"))
@@ -68,24 +89,66 @@ func initConfig() Config {
 		logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_RESEARCHER_NOTIFICATION: %v", err)
 	}
 
+	sms := 0
+	if v := os.Getenv("MESSAGE_SCHEDULER_INTERVAL_SMS"); v != "" {
+		sms, err = strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_SMS: %v", err)
+		}
+	}
+
+	push := 0
+	if v := os.Getenv("MESSAGE_SCHEDULER_INTERVAL_PUSH"); v != "" {
+		push, err = strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_PUSH: %v", err)
+		}
+	}
+
+	webhooks := 0
+	if v := os.Getenv("MESSAGE_SCHEDULER_INTERVAL_WEBHOOKS"); v != "" {
+		webhooks, err = strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_WEBHOOKS: %v", err)
+		}
+	}
+
+	digests := 0
+	if v := os.Getenv("MESSAGE_SCHEDULER_INTERVAL_NOTIFICATION_DIGEST"); v != "" {
+		digests, err = strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatalf("cannot parse MESSAGE_SCHEDULER_INTERVAL_NOTIFICATION_DIGEST: %v", err)
+		}
+	}
+
 	conf.LogLevel = config.GetLogLevel()
 
 	conf.Frequencies = struct {
-		HighPrio                int
-		LowPrio                 int
+		OutgoingEmails          map[string]int // per priority
 		AutoMessage             int
 		ParticipantMessages     int
 		ResearcherNotifications int
+		OutgoingSMS             int
+		OutgoingPush            int
+		OutgoingWebhooks        int
+		NotificationDigests     int
 	}{
-		HighPrio:                hp,
-		LowPrio:                 lp,
+		OutgoingEmails:          outgoingEmails,
 		AutoMessage:             am,
 		ParticipantMessages:     pm,
 		ResearcherNotifications: rn,
+		OutgoingSMS:             sms,
+		OutgoingPush:            push,
+		OutgoingWebhooks:        webhooks,
+		NotificationDigests:     digests,
 	}
+	conf.BatchParticipantMessages = os.Getenv("MESSAGE_SCHEDULER_BATCH_PARTICIPANT_MESSAGES") == "true"
 	conf.ServiceURLs.UserManagementService = os.Getenv("ADDR_USER_MANAGEMENT_SERVICE")
 	conf.ServiceURLs.StudyService = os.Getenv("ADDR_STUDY_SERVICE")
 	conf.ServiceURLs.EmailClientService = os.Getenv("ADDR_EMAIL_CLIENT_SERVICE")
+	conf.SMSProvider = config.GetSMSProviderConfig()
+	conf.VAPID = config.GetVAPIDConfig()
+	conf.Webhooks = config.GetWebhookClientConfig()
 	conf.MessageDBConfig = config.GetMessageDBConfig()
 	conf.GlobalDBConfig = config.GetGlobalDBConfig()
 	return conf
@@ -114,11 +177,33 @@ func main() {
 	messageDBService := messagedb.NewMessageDBService(conf.MessageDBConfig)
 	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
 
-	go runnerForLowPrioOutgoingEmails(messageDBService, globalDBService, clients, conf.Frequencies.LowPrio)
+	createIndexes(messageDBService, globalDBService)
+
+	emailSender := channels.NewEmailSender(clients.EmailClientService)
+
+	for _, priority := range types.PRIORITIES {
+		go runnerForOutgoingEmails(messageDBService, globalDBService, emailSender, priority, conf.Frequencies.OutgoingEmails[priority])
+	}
+	go runnerForOutgoingSMS(messageDBService, globalDBService, conf.SMSProvider, conf.Frequencies.OutgoingSMS)
+	go runnerForOutgoingPush(messageDBService, globalDBService, conf.VAPID, conf.Frequencies.OutgoingPush)
+	go runnerForOutgoingWebhooks(messageDBService, globalDBService, conf.Webhooks, conf.Frequencies.OutgoingWebhooks)
 	go runnerForAutoMessages(messageDBService, globalDBService, clients, conf.Frequencies.AutoMessage)
-	go runnerForParticipantMessages(messageDBService, globalDBService, clients, conf.Frequencies.ParticipantMessages)
+	go runnerForParticipantMessages(messageDBService, globalDBService, clients, conf.Frequencies.ParticipantMessages, conf.BatchParticipantMessages)
 	go runnerForResearcherNotifications(messageDBService, globalDBService, clients, conf.Frequencies.ResearcherNotifications)
-	runnerForHighPrioOutgoingEmails(messageDBService, globalDBService, clients, conf.Frequencies.HighPrio)
+	go runnerForNotificationDigests(messageDBService, globalDBService, clients, conf.Frequencies.NotificationDigests)
+	select {}
+}
+
+func createIndexes(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService) {
+	instances, err := gdb.GetAllInstances()
+	if err != nil {
+		logger.Error.Printf("%v", err)
+	}
+	for _, instance := range instances {
+		if err := mdb.CreateIndexes(instance.InstanceID); err != nil {
+			logger.Error.Printf("indexes of %s could not be created: %v", instance.InstanceID, err)
+		}
+	}
 }
 
 func logInitialLoopStartedMsg(loopName string, period time.Duration) {
@@ -129,29 +214,68 @@ func getThreadLockInterval(freq int) int64 {
 	return int64(float64(freq) * 2.5)
 }
 
-func runnerForHighPrioOutgoingEmails(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, freq int) {
+// runnerForOutgoingEmails sends the emails of one priority, so that a large newsletter does not delay the emails of
+// the higher priorities
+func runnerForOutgoingEmails(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, sender channels.Sender, priority string, freq int) {
 	period := time.Duration(freq) * time.Second
-	logInitialLoopStartedMsg("high prio outgoing emails", period)
+	logInitialLoopStartedMsg(priority+" outgoing emails", period)
 
 	lastAttemptOlderThan := getThreadLockInterval(freq)
 	for {
-		go handleOutgoingEmails(mdb, gdb, clients, lastAttemptOlderThan, true)
+		go handleOutgoingEmails(mdb, gdb, sender, lastAttemptOlderThan, priority)
 		time.Sleep(period)
 	}
 }
 
-func runnerForLowPrioOutgoingEmails(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, freq int) {
+func runnerForOutgoingSMS(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, smsProvider sms_client.SMSProviderConfig, freq int) {
+	if freq <= 0 || smsProvider.URL == "" {
+		logger.Debug.Println("no period or SMS provider defined for outgoing SMS, loop is skipped.")
+		return
+	}
+	sender := channels.NewSMSSender(sms_client.NewSMSClient(smsProvider))
 	period := time.Duration(freq) * time.Second
-	logInitialLoopStartedMsg("low prio outgoing emails", period)
+	logInitialLoopStartedMsg("outgoing SMS", period)
 
 	olderThan := getThreadLockInterval(freq)
 	for {
-		go handleOutgoingEmails(mdb, gdb, clients, olderThan, false)
+		go handleOutgoingSMS(mdb, gdb, sender, olderThan)
 		time.Sleep(period)
 	}
 }
 
-func runnerForParticipantMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, freq int) {
+func runnerForOutgoingPush(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, vapid push_client.VAPIDConfig, freq int) {
+	if freq <= 0 || vapid.PrivateKey == "" {
+		logger.Debug.Println("no period or VAPID keys defined for push notifications, loop is skipped.")
+		return
+	}
+	sender := channels.NewPushSender(push_client.NewPushClient(vapid))
+	period := time.Duration(freq) * time.Second
+	logInitialLoopStartedMsg("outgoing push notifications", period)
+
+	olderThan := getThreadLockInterval(freq)
+	for {
+		go handleOutgoingPush(mdb, gdb, sender, olderThan)
+		time.Sleep(period)
+	}
+}
+
+func runnerForOutgoingWebhooks(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, webhookConfig webhook_client.WebhookClientConfig, freq int) {
+	if freq <= 0 {
+		logger.Debug.Println("no period defined for outgoing webhooks, loop is skipped.")
+		return
+	}
+	sender := channels.NewWebhookSender(webhook_client.NewWebhookClient(webhookConfig))
+	period := time.Duration(freq) * time.Second
+	logInitialLoopStartedMsg("outgoing webhooks", period)
+
+	olderThan := getThreadLockInterval(freq)
+	for {
+		go handleOutgoingWebhooks(mdb, gdb, sender, olderThan, webhookConfig.AllowHTTP)
+		time.Sleep(period)
+	}
+}
+
+func runnerForParticipantMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, freq int, batchMessages bool) {
 	if freq <= 0 {
 		logger.Debug.Println("no period defined for participant messages, loop is skipped.")
 		return
@@ -159,7 +283,7 @@ func runnerForParticipantMessages(mdb *messagedb.MessageDBService, gdb *globaldb
 	period := time.Duration(freq) * time.Second
 	logInitialLoopStartedMsg("participant messages", period)
 	for {
-		go handleParticipantMessages(mdb, gdb, clients)
+		go handleParticipantMessages(mdb, gdb, clients, batchMessages)
 		time.Sleep(period)
 	}
 }
@@ -177,6 +301,19 @@ func runnerForResearcherNotifications(mdb *messagedb.MessageDBService, gdb *glob
 	}
 }
 
+func runnerForNotificationDigests(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, freq int) {
+	if freq <= 0 {
+		logger.Debug.Println("no period defined for notification digests, loop is skipped.")
+		return
+	}
+	period := time.Duration(freq) * time.Second
+	logInitialLoopStartedMsg("notification digests", period)
+	for {
+		go handleNotificationDigests(mdb, gdb, clients)
+		time.Sleep(period)
+	}
+}
+
 func runnerForAutoMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, freq int) {
 	period := time.Duration(freq) * time.Second
 	logInitialLoopStartedMsg("auto messages", period)
@@ -186,15 +323,10 @@ func runnerForAutoMessages(mdb *messagedb.MessageDBService, gdb *globaldb.Global
 	}
 }
 
-func handleOutgoingEmails(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, lastAttemptOlderThan int64, onlyHighPrio bool) {
-	threadName := "lpOE"
-	taskDescription := "fetching and sending low prio outgoing emails"
-	if onlyHighPrio {
-		threadName = "hpOE"
-		taskDescription = "fetching and sending high prio outgoing emails"
-	}
+func handleOutgoingEmails(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, sender channels.Sender, lastAttemptOlderThan int64, priority string) {
+	taskDescription := "fetching and sending " + priority + " outgoing emails"
 
-	threadID := generateThreadID(threadName)
+	threadID := generateThreadID("OE-" + priority)
 	logger.Info.Printf("--> Process <%s> started: %s...", threadID, taskDescription)
 
 	var wg sync.WaitGroup
@@ -204,17 +336,17 @@ func handleOutgoingEmails(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalD
 	}
 	for _, instance := range instances {
 		wg.Add(1)
-		go handleOutgoingForInstanceID(mdb, instance.InstanceID, clients, lastAttemptOlderThan, onlyHighPrio, &wg)
+		go handleOutgoingForInstanceID(mdb, instance.InstanceID, sender, lastAttemptOlderThan, priority, &wg)
 	}
 	wg.Wait()
 	logger.Info.Printf("<-- Process <%s> finished: %s", threadID, taskDescription)
 }
 
-func handleOutgoingForInstanceID(mdb *messagedb.MessageDBService, instanceID string, clients *types.APIClients, lastAttemptOlderThan int64, onlyHighPrio bool, wg *sync.WaitGroup) {
+func handleOutgoingForInstanceID(mdb *messagedb.MessageDBService, instanceID string, sender channels.Sender, lastAttemptOlderThan int64, priority string, wg *sync.WaitGroup) {
 	defer wg.Done()
 	counters := types.InitMessageCounter()
 	for {
-		emails, err := mdb.FetchOutgoingEmails(instanceID, outgoingBatchSize, lastAttemptOlderThan, onlyHighPrio)
+		emails, err := mdb.FetchOutgoingEmails(instanceID, outgoingBatchSize, lastAttemptOlderThan, priority)
 		if err != nil {
 			logger.Error.Printf("%s: %v", instanceID, err)
 			break
@@ -238,13 +370,7 @@ func handleOutgoingForInstanceID(mdb *messagedb.MessageDBService, instanceID str
 				continue
 			}
 
-			_, err := clients.EmailClientService.SendEmail(context.Background(), &emailAPI.SendEmailReq{
-				To:              email.To,
-				HeaderOverrides: email.HeaderOverrides.ToEmailClientAPI(),
-				Subject:         email.Subject,
-				Content:         email.Content,
-				HighPrio:        email.HighPrio,
-			})
+			err := sender.Send(context.Background(), channels.MessageFromOutgoingEmail(instanceID, email))
 			if err != nil {
 				logger.Error.Printf("Could not send email ('%s') in instance %s: %v", email.MessageType, instanceID, err)
 				counters.IncreaseCounter(false)
@@ -269,11 +395,273 @@ func handleOutgoingForInstanceID(mdb *messagedb.MessageDBService, instanceID str
 		}
 	}
 	counters.Stop()
-	prioText := ""
-	if onlyHighPrio {
-		prioText = " with high prio"
+	logger.Info.Printf("[%s] Finished processing %d messages with %s priority in %d s.", instanceID, counters.Success, priority, counters.Duration)
+}
+
+func handleOutgoingSMS(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, sender channels.Sender, lastAttemptOlderThan int64) {
+	threadID := generateThreadID("SMS")
+	logger.Info.Printf("--> Process <%s> started: fetching and sending outgoing SMS...", threadID)
+
+	var wg sync.WaitGroup
+	instances, err := gdb.GetAllInstances()
+	if err != nil {
+		logger.Error.Printf("%v", err)
+	}
+	for _, instance := range instances {
+		wg.Add(1)
+		go handleOutgoingSMSForInstanceID(mdb, instance.InstanceID, sender, lastAttemptOlderThan, &wg)
+	}
+	wg.Wait()
+	logger.Info.Printf("<-- Process <%s> finished: fetching and sending outgoing SMS", threadID)
+}
+
+func handleOutgoingSMSForInstanceID(mdb *messagedb.MessageDBService, instanceID string, sender channels.Sender, lastAttemptOlderThan int64, wg *sync.WaitGroup) {
+	defer wg.Done()
+	counters := types.InitMessageCounter()
+	for {
+		messages, err := mdb.FetchOutgoingSMS(instanceID, outgoingBatchSize, lastAttemptOlderThan, false)
+		if err != nil {
+			logger.Error.Printf("%s: %v", instanceID, err)
+			break
+		}
+		if len(messages) < 1 {
+			break
+		}
+		lastFetch := time.Now().Unix()
+
+		for _, sms := range messages {
+			batchDuration := time.Now().Unix() - lastFetch
+			if batchDuration > int64(float64(lastAttemptOlderThan)*0.9) {
+				// if process takes too long, skip remaining messages of this batch
+				logger.Warning.Printf("Skip sending SMS ('%s') in instance %s because batch duration was too long (%d)", sms.MessageType, instanceID, counters.Duration)
+				counters.IncreaseCounter(false)
+
+				err = mdb.ResetLastSendAttemptForOutgoingSMS(instanceID, sms.ID.Hex())
+				if err != nil {
+					logger.Error.Printf("Error while resetting lastSendAttempt for a SMS ('%s') in instance %s: %v", sms.MessageType, instanceID, err)
+				}
+				continue
+			}
+
+			err := sender.Send(context.Background(), channels.MessageFromOutgoingSMS(sms))
+			if err != nil {
+				logger.Error.Printf("Could not send SMS ('%s') in instance %s: %v", sms.MessageType, instanceID, err)
+				counters.IncreaseCounter(false)
+
+				err = mdb.ResetLastSendAttemptForOutgoingSMS(instanceID, sms.ID.Hex())
+				if err != nil {
+					logger.Error.Printf("Error while resetting lastSendAttempt for a SMS ('%s') in instance %s: %v", sms.MessageType, instanceID, err)
+				}
+				continue
+			}
+
+			_, err = mdb.AddToSentSMS(instanceID, sms)
+			if err != nil {
+				logger.Error.Printf("Error while saving to sent: %v", err)
+				continue
+			}
+			err = mdb.DeleteOutgoingSMS(instanceID, sms.ID.Hex())
+			if err != nil {
+				logger.Error.Printf("Error while deleting outgoing SMS of type '%s': %v", sms.MessageType, err)
+			}
+			counters.IncreaseCounter(true)
+		}
+	}
+	counters.Stop()
+	logger.Info.Printf("[%s] Finished processing %d SMS in %d s.", instanceID, counters.Success, counters.Duration)
+}
+
+func handleOutgoingPush(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, sender channels.Sender, lastAttemptOlderThan int64) {
+	threadID := generateThreadID("PUSH")
+	logger.Info.Printf("--> Process <%s> started: fetching and sending outgoing push notifications...", threadID)
+
+	var wg sync.WaitGroup
+	instances, err := gdb.GetAllInstances()
+	if err != nil {
+		logger.Error.Printf("%v", err)
+	}
+	for _, instance := range instances {
+		wg.Add(1)
+		go handleOutgoingPushForInstanceID(mdb, instance.InstanceID, sender, lastAttemptOlderThan, &wg)
+	}
+	wg.Wait()
+	logger.Info.Printf("<-- Process <%s> finished: fetching and sending outgoing push notifications", threadID)
+}
+
+func handleOutgoingPushForInstanceID(mdb *messagedb.MessageDBService, instanceID string, sender channels.Sender, lastAttemptOlderThan int64, wg *sync.WaitGroup) {
+	defer wg.Done()
+	counters := types.InitMessageCounter()
+	for {
+		messages, err := mdb.FetchOutgoingPush(instanceID, outgoingBatchSize, lastAttemptOlderThan, false)
+		if err != nil {
+			logger.Error.Printf("%s: %v", instanceID, err)
+			break
+		}
+		if len(messages) < 1 {
+			break
+		}
+		lastFetch := time.Now().Unix()
+
+		for _, push := range messages {
+			batchDuration := time.Now().Unix() - lastFetch
+			if batchDuration > int64(float64(lastAttemptOlderThan)*0.9) {
+				// if process takes too long, skip remaining messages of this batch
+				logger.Warning.Printf("Skip sending push notification ('%s') in instance %s because batch duration was too long (%d)", push.MessageType, instanceID, counters.Duration)
+				counters.IncreaseCounter(false)
+
+				err = mdb.ResetLastSendAttemptForOutgoingPush(instanceID, push.ID.Hex())
+				if err != nil {
+					logger.Error.Printf("Error while resetting lastSendAttempt for a push notification ('%s') in instance %s: %v", push.MessageType, instanceID, err)
+				}
+				continue
+			}
+
+			err := sender.Send(context.Background(), channels.MessageFromOutgoingPush(push))
+			if errors.Is(err, push_client.ErrSubscriptionGone) {
+				// the user unsubscribed in the browser, retrying is pointless
+				logger.Info.Printf("Push subscription of user %s in instance %s is gone and will be removed", push.Subscription.UserID, instanceID)
+				counters.IncreaseCounter(false)
+
+				if err := mdb.DeletePushSubscription(instanceID, "", push.Subscription.Endpoint); err != nil {
+					logger.Error.Printf("Error while deleting push subscription in instance %s: %v", instanceID, err)
+				}
+				if err := mdb.DeleteOutgoingPush(instanceID, push.ID.Hex()); err != nil {
+					logger.Error.Printf("Error while deleting outgoing push notification of type '%s': %v", push.MessageType, err)
+				}
+				continue
+			}
+			if err != nil {
+				logger.Error.Printf("Could not send push notification ('%s') in instance %s: %v", push.MessageType, instanceID, err)
+				counters.IncreaseCounter(false)
+
+				err = mdb.ResetLastSendAttemptForOutgoingPush(instanceID, push.ID.Hex())
+				if err != nil {
+					logger.Error.Printf("Error while resetting lastSendAttempt for a push notification ('%s') in instance %s: %v", push.MessageType, instanceID, err)
+				}
+				continue
+			}
+
+			_, err = mdb.AddToSentPush(instanceID, push)
+			if err != nil {
+				logger.Error.Printf("Error while saving to sent: %v", err)
+				continue
+			}
+			err = mdb.DeleteOutgoingPush(instanceID, push.ID.Hex())
+			if err != nil {
+				logger.Error.Printf("Error while deleting outgoing push notification of type '%s': %v", push.MessageType, err)
+			}
+			counters.IncreaseCounter(true)
+		}
+	}
+	counters.Stop()
+	logger.Info.Printf("[%s] Finished processing %d push notifications in %d s.", instanceID, counters.Success, counters.Duration)
+}
+
+func handleOutgoingWebhooks(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, sender channels.Sender, lastAttemptOlderThan int64, allowHTTP bool) {
+	threadID := generateThreadID("WH")
+	logger.Info.Printf("--> Process <%s> started: fetching and sending outgoing webhooks...", threadID)
+
+	var wg sync.WaitGroup
+	instances, err := gdb.GetAllInstances()
+	if err != nil {
+		logger.Error.Printf("%v", err)
+	}
+	for _, instance := range instances {
+		wg.Add(1)
+		go handleOutgoingWebhooksForInstanceID(mdb, instance.InstanceID, sender, lastAttemptOlderThan, allowHTTP, &wg)
+	}
+	wg.Wait()
+	logger.Info.Printf("<-- Process <%s> finished: fetching and sending outgoing webhooks", threadID)
+}
+
+func handleOutgoingWebhooksForInstanceID(mdb *messagedb.MessageDBService, instanceID string, sender channels.Sender, lastAttemptOlderThan int64, allowHTTP bool, wg *sync.WaitGroup) {
+	defer wg.Done()
+	counters := types.InitMessageCounter()
+	for {
+		webhooks, err := mdb.FetchOutgoingWebhooks(instanceID, outgoingBatchSize, lastAttemptOlderThan)
+		if err != nil {
+			logger.Error.Printf("%s: %v", instanceID, err)
+			break
+		}
+		if len(webhooks) < 1 {
+			break
+		}
+		lastFetch := time.Now().Unix()
+
+		for _, webhook := range webhooks {
+			batchDuration := time.Now().Unix() - lastFetch
+			if batchDuration > int64(float64(lastAttemptOlderThan)*0.9) {
+				// if process takes too long, skip remaining messages of this batch
+				logger.Warning.Printf("Skip sending webhook ('%s') in instance %s because batch duration was too long (%d)", webhook.MessageType, instanceID, counters.Duration)
+				counters.IncreaseCounter(false)
+
+				err = mdb.ResetLastSendAttemptForOutgoingWebhook(instanceID, webhook.ID.Hex())
+				if err != nil {
+					logger.Error.Printf("Error while resetting lastSendAttempt for a webhook ('%s') in instance %s: %v", webhook.MessageType, instanceID, err)
+				}
+				continue
+			}
+
+			delivered := sendOutgoingWebhook(mdb, instanceID, sender, webhook, allowHTTP)
+			counters.IncreaseCounter(delivered)
+		}
+	}
+	counters.Stop()
+	logger.Info.Printf("[%s] Finished processing %d webhooks in %d s.", instanceID, counters.Success, counters.Duration)
+}
+
+// sendOutgoingWebhook posts the notification to its target and records the attempt in the delivery log. Failed
+// attempts are retried with increasing delay, until the maximum number of attempts is reached. The URL of the target is
+// checked again, as targets may have been saved before the check.
+func sendOutgoingWebhook(mdb *messagedb.MessageDBService, instanceID string, sender channels.Sender, webhook types.OutgoingWebhook, allowHTTP bool) bool {
+	delivery := types.WebhookDelivery{
+		TargetName:     webhook.TargetName,
+		MessageType:    webhook.MessageType,
+		StudyKey:       webhook.StudyKey,
+		NotificationID: webhook.NotificationID,
+		Attempt:        webhook.Attempts + 1,
+		SentAt:         time.Now().Unix(),
+	}
+
+	target, err := mdb.FindWebhookTarget(instanceID, webhook.TargetName)
+	if err != nil {
+		// the target was deleted after the notification was queued, retrying is pointless
+		err = fmt.Errorf("webhook target not found: %v", err)
+		delivery.Attempt = webhook_client.MAX_ATTEMPTS
+	} else if err = types.CheckWebhookURL(target.URL, allowHTTP); err != nil {
+		delivery.Attempt = webhook_client.MAX_ATTEMPTS
+	} else {
+		err = sender.Send(context.Background(), channels.MessageFromOutgoingWebhook(webhook, target))
+	}
+
+	if err != nil {
+		delivery.Error = err.Error()
+		var statusErr *webhook_client.StatusError
+		if errors.As(err, &statusErr) {
+			delivery.StatusCode = statusErr.StatusCode
+		}
+		logger.Error.Printf("Could not send webhook ('%s') to '%s' in instance %s (attempt %d): %v", webhook.MessageType, webhook.TargetName, instanceID, delivery.Attempt, err)
+	} else {
+		delivery.Delivered = true
 	}
-	logger.Info.Printf("[%s] Finished processing %d messages%s in %d s.", instanceID, counters.Success, prioText, counters.Duration)
+	if _, err := mdb.AddWebhookDelivery(instanceID, delivery); err != nil {
+		logger.Error.Printf("Error while saving webhook delivery: %v", err)
+	}
+
+	if !delivery.Delivered && delivery.Attempt < webhook_client.MAX_ATTEMPTS {
+		nextAttempt := time.Now().Add(webhook_client.RetryDelay(delivery.Attempt)).Unix()
+		if err := mdb.ScheduleRetryForOutgoingWebhook(instanceID, webhook.ID.Hex(), delivery.Attempt, nextAttempt); err != nil {
+			logger.Error.Printf("Error while scheduling retry for a webhook ('%s') in instance %s: %v", webhook.MessageType, instanceID, err)
+		}
+		return false
+	}
+	if !delivery.Delivered {
+		logger.Warning.Printf("Webhook ('%s') to '%s' in instance %s is dropped after %d attempts", webhook.MessageType, webhook.TargetName, instanceID, delivery.Attempt)
+	}
+	if err := mdb.DeleteOutgoingWebhook(instanceID, webhook.ID.Hex()); err != nil {
+		logger.Error.Printf("Error while deleting outgoing webhook of type '%s': %v", webhook.MessageType, err)
+	}
+	return delivery.Delivered
 }
 
 func handleAutoMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients) {
@@ -335,7 +723,7 @@ func handleAutoMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBS
 	logger.Info.Printf("<-- Process <%s> finished: fetching and sending scheduled auto messages", threadID)
 }
 
-func handleParticipantMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients) {
+func handleParticipantMessages(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients, batchMessages bool) {
 	threadID := generateThreadID("PM")
 	logger.Info.Printf("--> Process <%s> started: fetching and sending scheduled participant messages...", threadID)
 	var wg sync.WaitGroup
@@ -350,6 +738,7 @@ func handleParticipantMessages(mdb *messagedb.MessageDBService, gdb *globaldb.Gl
 			mdb,
 			instance.InstanceID,
 			fmt.Sprintf("`%s`", instance.InstanceID),
+			batchMessages,
 			&wg,
 		)
 	}
@@ -380,6 +769,29 @@ func handleResearcherNotifications(mdb *messagedb.MessageDBService, gdb *globald
 	logger.Info.Printf("<-- Process <%s> finished: fetching and sending researcher notifications", threadID)
 }
 
+func handleNotificationDigests(mdb *messagedb.MessageDBService, gdb *globaldb.GlobalDBService, clients *types.APIClients) {
+	threadID := generateThreadID("ND")
+	logger.Info.Printf("--> Process <%s> started: sending due notification digests", threadID)
+
+	var wg sync.WaitGroup
+	instances, err := gdb.GetAllInstances()
+	if err != nil {
+		logger.Error.Printf("GetAllInstances: %v", err)
+	}
+	for _, instance := range instances {
+		wg.Add(1)
+		go bulk_messages.GenerateNotificationDigests(
+			clients,
+			mdb,
+			instance.InstanceID,
+			fmt.Sprintf("notification digests for `%s`", instance.InstanceID),
+			&wg,
+		)
+	}
+	wg.Wait()
+	logger.Info.Printf("<-- Process <%s> finished: sending due notification digests", threadID)
+}
+
 func generateThreadID(threadName string) string {
 	newID := uuid.New().String()
 	threadID := threadName + "-" + newID[:8]
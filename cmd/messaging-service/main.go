@@ -15,9 +15,11 @@ import (
 
 // Config is the structure that holds all global configuration data
 type Config struct {
-	Port            string
-	MessageDBConfig types.DBConfig
-	ServiceURLs     struct {
+	Port                      string
+	MessageDBConfig           types.DBConfig
+	PublishTemplatesAdminOnly bool
+	AllowHTTPWebhooks         bool
+	ServiceURLs               struct {
 		UserManagementService string
 		EmailClientService    string
 		StudyService          string
@@ -33,6 +35,8 @@ func initConfig() Config {
 	conf.ServiceURLs.LoggingService = os.Getenv("ADDR_LOGGING_SERVICE")
 	conf.ServiceURLs.EmailClientService = os.Getenv("ADDR_EMAIL_CLIENT_SERVICE")
 	conf.MessageDBConfig = config.GetMessageDBConfig()
+	conf.PublishTemplatesAdminOnly = os.Getenv("EMAIL_TEMPLATE_PUBLISH_ADMIN_ONLY") == "true"
+	conf.AllowHTTPWebhooks = os.Getenv("WEBHOOK_ALLOW_HTTP") == "true"
 	return conf
 }
 
@@ -59,6 +63,7 @@ func main() {
 	// <---
 
 	messageDBService := messagedb.NewMessageDBService(conf.MessageDBConfig)
+	createIndexes(messageDBService)
 
 	ctx := context.Background()
 
@@ -67,7 +72,24 @@ func main() {
 		conf.Port,
 		clients,
 		messageDBService,
+		conf.PublishTemplatesAdminOnly,
+		conf.AllowHTTPWebhooks,
 	); err != nil {
 		logger.Error.Fatal(err)
 	}
 }
+
+// createIndexes creates the indexes of the existing instances, the indexes of new instances are created on their
+// first template save
+func createIndexes(mdb *messagedb.MessageDBService) {
+	instanceIDs, err := mdb.InstanceIDs()
+	if err != nil {
+		logger.Error.Printf("instances could not be listed for the indexes: %v", err)
+		return
+	}
+	for _, instanceID := range instanceIDs {
+		if err := mdb.CreateIndexes(instanceID); err != nil {
+			logger.Error.Printf("indexes of %s could not be created: %v", instanceID, err)
+		}
+	}
+}
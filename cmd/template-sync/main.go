@@ -0,0 +1,227 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/internal/config"
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/template_files"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+const usage = `Usage:
+  template-sync export -instance <instance-id> -dir <dir> [-drafts]
+  template-sync import -instance <instance-id> -dir <dir> [-dry-run] [-author <name>]
+
+Exports the email templates and auto messages of an instance into a directory of YAML and HTML files, or imports them
+from there. The import prints the changes, validates the templates and saves changed templates as drafts.
+The message DB is configured with the same env variables as the messaging service.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	flags.Usage = func() { fmt.Print(usage) }
+	instanceID := flags.String("instance", "", "instance ID")
+	dir := flags.String("dir", "", "directory of the template files")
+	drafts := flags.Bool("drafts", false, "export: export drafts instead of the published templates, if there are any")
+	dryRun := flags.Bool("dry-run", false, "import: only print the changes and validate the templates, nothing is saved")
+	author := flags.String("author", "template-sync", "import: author recorded in the template versions")
+	if err := flags.Parse(os.Args[2:]); err != nil || *instanceID == "" || *dir == "" {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	logger.SetLevel(config.GetLogLevel())
+
+	switch os.Args[1] {
+	case "export":
+		messageDBService := messagedb.NewMessageDBService(config.GetMessageDBConfig())
+		if err := exportFiles(messageDBService, *instanceID, *dir, *drafts); err != nil {
+			logger.Error.Fatal(err)
+		}
+	case "import":
+		messageDBService := messagedb.NewMessageDBService(config.GetMessageDBConfig())
+		if !importFiles(messageDBService, *instanceID, *dir, *dryRun, *author) {
+			os.Exit(1)
+		}
+	default:
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+}
+
+func exportFiles(mdb *messagedb.MessageDBService, instanceID string, dir string, drafts bool) error {
+	allTemplates, err := mdb.FindAllEmailTempates(instanceID)
+	if err != nil {
+		return err
+	}
+	autoMessages, err := mdb.FindAutoMessages(instanceID, false)
+	if err != nil {
+		return err
+	}
+
+	// one file set per template: the published template, or its draft if requested
+	emailTemplates := []types.EmailTemplate{}
+	index := map[string]int{}
+	for _, t := range allTemplates {
+		key := template_files.EmailTemplateDirName(t)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(emailTemplates)
+			emailTemplates = append(emailTemplates, t)
+		} else if t.IsDraft() == drafts {
+			emailTemplates[i] = t
+		}
+	}
+
+	if err := template_files.Export(dir, emailTemplates, autoMessages); err != nil {
+		return err
+	}
+	logger.Info.Printf("exported %d email templates and %d auto messages of %s to %s", len(emailTemplates), len(autoMessages), instanceID, dir)
+	return nil
+}
+
+// importFiles returns false if a template is invalid or could not be saved
+func importFiles(mdb *messagedb.MessageDBService, instanceID string, dir string, dryRun bool, author string) bool {
+	emailTemplates, autoMessages, err := template_files.ReadAll(dir)
+	if err != nil {
+		logger.Error.Printf("files could not be read: %v", err)
+		return false
+	}
+	partials, err := mdb.FindAllTemplatePartials(instanceID)
+	if err != nil {
+		logger.Error.Printf("template partials could not be loaded: %v", err)
+		return false
+	}
+	existingMessages, err := mdb.FindAutoMessages(instanceID, false)
+	if err != nil {
+		logger.Error.Printf("auto messages could not be loaded: %v", err)
+		return false
+	}
+
+	ok := true
+	changed := 0
+	for _, t := range emailTemplates {
+		name := fmt.Sprintf("email template %s:%s", t.MessageType, t.StudyKey)
+		// compared with the current draft, or the published template if there is no draft
+		prev, err := mdb.FindDraftEmailTemplate(instanceID, t.MessageType, t.StudyKey)
+		if err != nil {
+			prev, err = mdb.FindEmailTemplateByType(instanceID, t.MessageType, t.StudyKey)
+		}
+		isNew := err != nil
+		if !printChanges(name, isNew, template_files.DiffEmailTemplates(prev, t)) {
+			continue
+		}
+		if err := validateTemplate(instanceID, t, partials); err != nil {
+			fmt.Printf("  invalid: %v\n", err)
+			ok = false
+			continue
+		}
+		changed += 1
+		if dryRun {
+			continue
+		}
+		t.Status = types.EMAIL_TEMPLATE_STATUS_DRAFT
+		saved, err := mdb.SaveEmailTemplateVersion(instanceID, author, t, 0)
+		if err != nil {
+			logger.Error.Printf("%s could not be saved: %v", name, err)
+			ok = false
+			continue
+		}
+		fmt.Printf("  saved as draft version %d\n", saved.Version)
+	}
+
+	for _, m := range autoMessages {
+		name := fmt.Sprintf("auto message %s (%s)", m.ID.Hex(), m.Label)
+		prev, found := types.AutoMessage{}, false
+		for _, existing := range existingMessages {
+			if !m.ID.IsZero() && existing.ID == m.ID {
+				prev, found = existing, true
+			}
+		}
+		if !m.ID.IsZero() && !found {
+			logger.Error.Printf("%s not found, remove the id from the file to add it as a new auto message", name)
+			ok = false
+			continue
+		}
+		if found {
+			// the scheduler advances nextTime, the file must not rewind it
+			m.NextTime = prev.NextTime
+		}
+		if !printChanges(name, !found, template_files.DiffAutoMessages(prev, m)) {
+			continue
+		}
+		if err := m.CheckUntil(time.Now().Unix()); err != nil {
+			fmt.Printf("  invalid: %v\n", err)
+			ok = false
+			continue
+		}
+		if err := validateTemplate(instanceID, m.Template, partials); err != nil {
+			fmt.Printf("  invalid: %v\n", err)
+			ok = false
+			continue
+		}
+		changed += 1
+		if dryRun {
+			continue
+		}
+		if _, err := mdb.SaveAutoMessage(instanceID, m); err != nil {
+			logger.Error.Printf("%s could not be saved: %v", name, err)
+			ok = false
+			continue
+		}
+		fmt.Println("  saved")
+	}
+
+	if dryRun {
+		fmt.Printf("dry run: %d of %d email templates and auto messages would be saved\n", changed, len(emailTemplates)+len(autoMessages))
+	} else {
+		fmt.Printf("%d of %d email templates and auto messages saved\n", changed, len(emailTemplates)+len(autoMessages))
+	}
+	return ok
+}
+
+// printChanges prints the diff, and returns false if there are no changes
+func printChanges(name string, isNew bool, diff []string) bool {
+	if isNew {
+		fmt.Printf("%s: new\n", name)
+		return true
+	}
+	if len(diff) == 0 {
+		logger.Debug.Printf("%s: unchanged", name)
+		return false
+	}
+	fmt.Printf("%s: changed\n", name)
+	for _, line := range diff {
+		fmt.Println("  " + line)
+	}
+	return true
+}
+
+// validateTemplate returns an error for templates that cannot be saved, and prints the lint warnings
+func validateTemplate(instanceID string, t types.EmailTemplate, partials []types.TemplatePartial) error {
+	if err := templates.CheckAllTranslationsParsable(instanceID, t, partials); err != nil {
+		return err
+	}
+	if err := templates.CheckTemplateVariables(instanceID, t, partials); err != nil {
+		return err
+	}
+	if err := channels.CheckRoutingRule(t); err != nil {
+		return err
+	}
+	for _, w := range templates.LintTemplate(instanceID, t, partials) {
+		fmt.Printf("  warning [%s] %s: %s\n", w.Language, w.Rule, w.Msg)
+	}
+	return nil
+}
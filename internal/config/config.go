@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/push_client"
+	"github.com/influenzanet/messaging-service/pkg/sms_client"
 	"github.com/influenzanet/messaging-service/pkg/types"
+	"github.com/influenzanet/messaging-service/pkg/webhook_client"
 )
 
 func GetMessageDBConfig() types.DBConfig {
@@ -95,3 +99,52 @@ func GetGlobalDBConfig() types.DBConfig {
 		DBNamePrefix:    DBNamePrefix,
 	}
 }
+
+// GetSMSProviderConfig reads the HTTP API of the SMS provider, SMS are not sent if SMS_PROVIDER_URL is empty
+func GetSMSProviderConfig() sms_client.SMSProviderConfig {
+	conf := sms_client.SMSProviderConfig{
+		URL:    os.Getenv("SMS_PROVIDER_URL"),
+		APIKey: os.Getenv("SMS_PROVIDER_API_KEY"),
+		From:   os.Getenv("SMS_PROVIDER_FROM"),
+	}
+	if v := os.Getenv("SMS_PROVIDER_TIMEOUT"); v != "" {
+		timeout, err := strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatal("SMS_PROVIDER_TIMEOUT: " + err.Error())
+		}
+		conf.Timeout = time.Duration(timeout) * time.Second
+	}
+	return conf
+}
+
+// GetVAPIDConfig reads the keys identifying the service to push services, push notifications are not sent if
+// VAPID_PRIVATE_KEY is empty
+func GetVAPIDConfig() push_client.VAPIDConfig {
+	conf := push_client.VAPIDConfig{
+		PublicKey:  os.Getenv("VAPID_PUBLIC_KEY"),
+		PrivateKey: os.Getenv("VAPID_PRIVATE_KEY"),
+		Subject:    os.Getenv("VAPID_SUBJECT"),
+	}
+	if v := os.Getenv("PUSH_TTL"); v != "" {
+		ttl, err := strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatal("PUSH_TTL: " + err.Error())
+		}
+		conf.TTL = ttl
+	}
+	return conf
+}
+
+// GetWebhookClientConfig reads the settings of the requests to webhook targets
+func GetWebhookClientConfig() webhook_client.WebhookClientConfig {
+	conf := webhook_client.WebhookClientConfig{}
+	if v := os.Getenv("WEBHOOK_TIMEOUT"); v != "" {
+		timeout, err := strconv.Atoi(v)
+		if err != nil {
+			logger.Error.Fatal("WEBHOOK_TIMEOUT: " + err.Error())
+		}
+		conf.Timeout = time.Duration(timeout) * time.Second
+	}
+	conf.AllowHTTP = os.Getenv("WEBHOOK_ALLOW_HTTP") == "true"
+	return conf
+}
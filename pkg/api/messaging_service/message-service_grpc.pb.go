@@ -28,12 +28,35 @@ type MessagingServiceApiClient interface {
 	QueueEmailTemplateForSending(ctx context.Context, in *SendEmailReq, opts ...grpc.CallOption) (*ServiceStatus, error)
 	SendMessageToAllUsers(ctx context.Context, in *SendMessageToAllUsersReq, opts ...grpc.CallOption) (*ServiceStatus, error)
 	SendMessageToStudyParticipants(ctx context.Context, in *SendMessageToStudyParticipantsReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	PreviewMessageAudience(ctx context.Context, in *PreviewMessageAudienceReq, opts ...grpc.CallOption) (*MessageAudiencePreview, error)
+	SendTestEmail(ctx context.Context, in *SendTestEmailReq, opts ...grpc.CallOption) (*ServiceStatus, error)
 	GetAutoMessages(ctx context.Context, in *GetAutoMessagesReq, opts ...grpc.CallOption) (*AutoMessages, error)
 	SaveAutoMessage(ctx context.Context, in *SaveAutoMessageReq, opts ...grpc.CallOption) (*AutoMessage, error)
 	DeleteAutoMessage(ctx context.Context, in *DeleteAutoMessageReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetAutoMessageRuns(ctx context.Context, in *GetAutoMessageRunsReq, opts ...grpc.CallOption) (*AutoMessageRuns, error)
 	GetEmailTemplates(ctx context.Context, in *GetEmailTemplatesReq, opts ...grpc.CallOption) (*EmailTemplates, error)
 	SaveEmailTemplate(ctx context.Context, in *SaveEmailTemplateReq, opts ...grpc.CallOption) (*EmailTemplate, error)
 	DeleteEmailTemplate(ctx context.Context, in *DeleteEmailTemplateReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	RenderEmailTemplatePreview(ctx context.Context, in *RenderEmailTemplatePreviewReq, opts ...grpc.CallOption) (*EmailTemplatePreview, error)
+	GetEmailTemplateVersions(ctx context.Context, in *GetEmailTemplateVersionsReq, opts ...grpc.CallOption) (*EmailTemplateVersions, error)
+	GetEmailTemplateVersion(ctx context.Context, in *GetEmailTemplateVersionReq, opts ...grpc.CallOption) (*EmailTemplateVersion, error)
+	RollbackEmailTemplate(ctx context.Context, in *RollbackEmailTemplateReq, opts ...grpc.CallOption) (*EmailTemplate, error)
+	PublishEmailTemplate(ctx context.Context, in *PublishEmailTemplateReq, opts ...grpc.CallOption) (*EmailTemplate, error)
+	GetTemplatePartials(ctx context.Context, in *GetTemplatePartialsReq, opts ...grpc.CallOption) (*TemplatePartials, error)
+	SaveTemplatePartial(ctx context.Context, in *SaveTemplatePartialReq, opts ...grpc.CallOption) (*TemplatePartial, error)
+	DeleteTemplatePartial(ctx context.Context, in *DeleteTemplatePartialReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	SavePushSubscription(ctx context.Context, in *SavePushSubscriptionReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	DeletePushSubscription(ctx context.Context, in *DeletePushSubscriptionReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetChannelPreference(ctx context.Context, in *GetChannelPreferenceReq, opts ...grpc.CallOption) (*ChannelPreference, error)
+	SaveChannelPreference(ctx context.Context, in *SaveChannelPreferenceReq, opts ...grpc.CallOption) (*ChannelPreference, error)
+	GetWebhookTargets(ctx context.Context, in *GetWebhookTargetsReq, opts ...grpc.CallOption) (*WebhookTargets, error)
+	SaveWebhookTarget(ctx context.Context, in *SaveWebhookTargetReq, opts ...grpc.CallOption) (*WebhookTarget, error)
+	DeleteWebhookTarget(ctx context.Context, in *DeleteWebhookTargetReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesReq, opts ...grpc.CallOption) (*WebhookDeliveries, error)
+	GetNotificationDigestSettings(ctx context.Context, in *GetNotificationDigestSettingsReq, opts ...grpc.CallOption) (*NotificationDigestSettings, error)
+	SaveNotificationDigestSettings(ctx context.Context, in *SaveNotificationDigestSettingsReq, opts ...grpc.CallOption) (*NotificationDigestSettings, error)
+	GetFrequencyCap(ctx context.Context, in *GetFrequencyCapReq, opts ...grpc.CallOption) (*FrequencyCap, error)
+	SaveFrequencyCap(ctx context.Context, in *SaveFrequencyCapReq, opts ...grpc.CallOption) (*FrequencyCap, error)
 }
 
 type messagingServiceApiClient struct {
@@ -89,6 +112,24 @@ func (c *messagingServiceApiClient) SendMessageToStudyParticipants(ctx context.C
 	return out, nil
 }
 
+func (c *messagingServiceApiClient) PreviewMessageAudience(ctx context.Context, in *PreviewMessageAudienceReq, opts ...grpc.CallOption) (*MessageAudiencePreview, error) {
+	out := new(MessageAudiencePreview)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/PreviewMessageAudience", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SendTestEmail(ctx context.Context, in *SendTestEmailReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SendTestEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messagingServiceApiClient) GetAutoMessages(ctx context.Context, in *GetAutoMessagesReq, opts ...grpc.CallOption) (*AutoMessages, error) {
 	out := new(AutoMessages)
 	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetAutoMessages", in, out, opts...)
@@ -116,6 +157,15 @@ func (c *messagingServiceApiClient) DeleteAutoMessage(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *messagingServiceApiClient) GetAutoMessageRuns(ctx context.Context, in *GetAutoMessageRunsReq, opts ...grpc.CallOption) (*AutoMessageRuns, error) {
+	out := new(AutoMessageRuns)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetAutoMessageRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messagingServiceApiClient) GetEmailTemplates(ctx context.Context, in *GetEmailTemplatesReq, opts ...grpc.CallOption) (*EmailTemplates, error) {
 	out := new(EmailTemplates)
 	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetEmailTemplates", in, out, opts...)
@@ -143,6 +193,186 @@ func (c *messagingServiceApiClient) DeleteEmailTemplate(ctx context.Context, in
 	return out, nil
 }
 
+func (c *messagingServiceApiClient) RenderEmailTemplatePreview(ctx context.Context, in *RenderEmailTemplatePreviewReq, opts ...grpc.CallOption) (*EmailTemplatePreview, error) {
+	out := new(EmailTemplatePreview)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/RenderEmailTemplatePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetEmailTemplateVersions(ctx context.Context, in *GetEmailTemplateVersionsReq, opts ...grpc.CallOption) (*EmailTemplateVersions, error) {
+	out := new(EmailTemplateVersions)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetEmailTemplateVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetEmailTemplateVersion(ctx context.Context, in *GetEmailTemplateVersionReq, opts ...grpc.CallOption) (*EmailTemplateVersion, error) {
+	out := new(EmailTemplateVersion)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetEmailTemplateVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) RollbackEmailTemplate(ctx context.Context, in *RollbackEmailTemplateReq, opts ...grpc.CallOption) (*EmailTemplate, error) {
+	out := new(EmailTemplate)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/RollbackEmailTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) PublishEmailTemplate(ctx context.Context, in *PublishEmailTemplateReq, opts ...grpc.CallOption) (*EmailTemplate, error) {
+	out := new(EmailTemplate)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/PublishEmailTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetTemplatePartials(ctx context.Context, in *GetTemplatePartialsReq, opts ...grpc.CallOption) (*TemplatePartials, error) {
+	out := new(TemplatePartials)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetTemplatePartials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SaveTemplatePartial(ctx context.Context, in *SaveTemplatePartialReq, opts ...grpc.CallOption) (*TemplatePartial, error) {
+	out := new(TemplatePartial)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SaveTemplatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) DeleteTemplatePartial(ctx context.Context, in *DeleteTemplatePartialReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/DeleteTemplatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SavePushSubscription(ctx context.Context, in *SavePushSubscriptionReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SavePushSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) DeletePushSubscription(ctx context.Context, in *DeletePushSubscriptionReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/DeletePushSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetChannelPreference(ctx context.Context, in *GetChannelPreferenceReq, opts ...grpc.CallOption) (*ChannelPreference, error) {
+	out := new(ChannelPreference)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetChannelPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SaveChannelPreference(ctx context.Context, in *SaveChannelPreferenceReq, opts ...grpc.CallOption) (*ChannelPreference, error) {
+	out := new(ChannelPreference)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SaveChannelPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetWebhookTargets(ctx context.Context, in *GetWebhookTargetsReq, opts ...grpc.CallOption) (*WebhookTargets, error) {
+	out := new(WebhookTargets)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetWebhookTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SaveWebhookTarget(ctx context.Context, in *SaveWebhookTargetReq, opts ...grpc.CallOption) (*WebhookTarget, error) {
+	out := new(WebhookTarget)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SaveWebhookTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) DeleteWebhookTarget(ctx context.Context, in *DeleteWebhookTargetReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/DeleteWebhookTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesReq, opts ...grpc.CallOption) (*WebhookDeliveries, error) {
+	out := new(WebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetNotificationDigestSettings(ctx context.Context, in *GetNotificationDigestSettingsReq, opts ...grpc.CallOption) (*NotificationDigestSettings, error) {
+	out := new(NotificationDigestSettings)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetNotificationDigestSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SaveNotificationDigestSettings(ctx context.Context, in *SaveNotificationDigestSettingsReq, opts ...grpc.CallOption) (*NotificationDigestSettings, error) {
+	out := new(NotificationDigestSettings)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SaveNotificationDigestSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) GetFrequencyCap(ctx context.Context, in *GetFrequencyCapReq, opts ...grpc.CallOption) (*FrequencyCap, error) {
+	out := new(FrequencyCap)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/GetFrequencyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingServiceApiClient) SaveFrequencyCap(ctx context.Context, in *SaveFrequencyCapReq, opts ...grpc.CallOption) (*FrequencyCap, error) {
+	out := new(FrequencyCap)
+	err := c.cc.Invoke(ctx, "/influenzanet.message_service.MessagingServiceApi/SaveFrequencyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessagingServiceApiServer is the server API for MessagingServiceApi service.
 // All implementations must embed UnimplementedMessagingServiceApiServer
 // for forward compatibility
@@ -152,12 +382,35 @@ type MessagingServiceApiServer interface {
 	QueueEmailTemplateForSending(context.Context, *SendEmailReq) (*ServiceStatus, error)
 	SendMessageToAllUsers(context.Context, *SendMessageToAllUsersReq) (*ServiceStatus, error)
 	SendMessageToStudyParticipants(context.Context, *SendMessageToStudyParticipantsReq) (*ServiceStatus, error)
+	PreviewMessageAudience(context.Context, *PreviewMessageAudienceReq) (*MessageAudiencePreview, error)
+	SendTestEmail(context.Context, *SendTestEmailReq) (*ServiceStatus, error)
 	GetAutoMessages(context.Context, *GetAutoMessagesReq) (*AutoMessages, error)
 	SaveAutoMessage(context.Context, *SaveAutoMessageReq) (*AutoMessage, error)
 	DeleteAutoMessage(context.Context, *DeleteAutoMessageReq) (*ServiceStatus, error)
+	GetAutoMessageRuns(context.Context, *GetAutoMessageRunsReq) (*AutoMessageRuns, error)
 	GetEmailTemplates(context.Context, *GetEmailTemplatesReq) (*EmailTemplates, error)
 	SaveEmailTemplate(context.Context, *SaveEmailTemplateReq) (*EmailTemplate, error)
 	DeleteEmailTemplate(context.Context, *DeleteEmailTemplateReq) (*ServiceStatus, error)
+	RenderEmailTemplatePreview(context.Context, *RenderEmailTemplatePreviewReq) (*EmailTemplatePreview, error)
+	GetEmailTemplateVersions(context.Context, *GetEmailTemplateVersionsReq) (*EmailTemplateVersions, error)
+	GetEmailTemplateVersion(context.Context, *GetEmailTemplateVersionReq) (*EmailTemplateVersion, error)
+	RollbackEmailTemplate(context.Context, *RollbackEmailTemplateReq) (*EmailTemplate, error)
+	PublishEmailTemplate(context.Context, *PublishEmailTemplateReq) (*EmailTemplate, error)
+	GetTemplatePartials(context.Context, *GetTemplatePartialsReq) (*TemplatePartials, error)
+	SaveTemplatePartial(context.Context, *SaveTemplatePartialReq) (*TemplatePartial, error)
+	DeleteTemplatePartial(context.Context, *DeleteTemplatePartialReq) (*ServiceStatus, error)
+	SavePushSubscription(context.Context, *SavePushSubscriptionReq) (*ServiceStatus, error)
+	DeletePushSubscription(context.Context, *DeletePushSubscriptionReq) (*ServiceStatus, error)
+	GetChannelPreference(context.Context, *GetChannelPreferenceReq) (*ChannelPreference, error)
+	SaveChannelPreference(context.Context, *SaveChannelPreferenceReq) (*ChannelPreference, error)
+	GetWebhookTargets(context.Context, *GetWebhookTargetsReq) (*WebhookTargets, error)
+	SaveWebhookTarget(context.Context, *SaveWebhookTargetReq) (*WebhookTarget, error)
+	DeleteWebhookTarget(context.Context, *DeleteWebhookTargetReq) (*ServiceStatus, error)
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesReq) (*WebhookDeliveries, error)
+	GetNotificationDigestSettings(context.Context, *GetNotificationDigestSettingsReq) (*NotificationDigestSettings, error)
+	SaveNotificationDigestSettings(context.Context, *SaveNotificationDigestSettingsReq) (*NotificationDigestSettings, error)
+	GetFrequencyCap(context.Context, *GetFrequencyCapReq) (*FrequencyCap, error)
+	SaveFrequencyCap(context.Context, *SaveFrequencyCapReq) (*FrequencyCap, error)
 	mustEmbedUnimplementedMessagingServiceApiServer()
 }
 
@@ -180,6 +433,12 @@ func (UnimplementedMessagingServiceApiServer) SendMessageToAllUsers(context.Cont
 func (UnimplementedMessagingServiceApiServer) SendMessageToStudyParticipants(context.Context, *SendMessageToStudyParticipantsReq) (*ServiceStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessageToStudyParticipants not implemented")
 }
+func (UnimplementedMessagingServiceApiServer) PreviewMessageAudience(context.Context, *PreviewMessageAudienceReq) (*MessageAudiencePreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMessageAudience not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SendTestEmail(context.Context, *SendTestEmailReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTestEmail not implemented")
+}
 func (UnimplementedMessagingServiceApiServer) GetAutoMessages(context.Context, *GetAutoMessagesReq) (*AutoMessages, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAutoMessages not implemented")
 }
@@ -189,6 +448,9 @@ func (UnimplementedMessagingServiceApiServer) SaveAutoMessage(context.Context, *
 func (UnimplementedMessagingServiceApiServer) DeleteAutoMessage(context.Context, *DeleteAutoMessageReq) (*ServiceStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoMessage not implemented")
 }
+func (UnimplementedMessagingServiceApiServer) GetAutoMessageRuns(context.Context, *GetAutoMessageRunsReq) (*AutoMessageRuns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoMessageRuns not implemented")
+}
 func (UnimplementedMessagingServiceApiServer) GetEmailTemplates(context.Context, *GetEmailTemplatesReq) (*EmailTemplates, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmailTemplates not implemented")
 }
@@ -198,6 +460,66 @@ func (UnimplementedMessagingServiceApiServer) SaveEmailTemplate(context.Context,
 func (UnimplementedMessagingServiceApiServer) DeleteEmailTemplate(context.Context, *DeleteEmailTemplateReq) (*ServiceStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmailTemplate not implemented")
 }
+func (UnimplementedMessagingServiceApiServer) RenderEmailTemplatePreview(context.Context, *RenderEmailTemplatePreviewReq) (*EmailTemplatePreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderEmailTemplatePreview not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetEmailTemplateVersions(context.Context, *GetEmailTemplateVersionsReq) (*EmailTemplateVersions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailTemplateVersions not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetEmailTemplateVersion(context.Context, *GetEmailTemplateVersionReq) (*EmailTemplateVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailTemplateVersion not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) RollbackEmailTemplate(context.Context, *RollbackEmailTemplateReq) (*EmailTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackEmailTemplate not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) PublishEmailTemplate(context.Context, *PublishEmailTemplateReq) (*EmailTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishEmailTemplate not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetTemplatePartials(context.Context, *GetTemplatePartialsReq) (*TemplatePartials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplatePartials not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SaveTemplatePartial(context.Context, *SaveTemplatePartialReq) (*TemplatePartial, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTemplatePartial not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) DeleteTemplatePartial(context.Context, *DeleteTemplatePartialReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplatePartial not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SavePushSubscription(context.Context, *SavePushSubscriptionReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePushSubscription not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) DeletePushSubscription(context.Context, *DeletePushSubscriptionReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePushSubscription not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetChannelPreference(context.Context, *GetChannelPreferenceReq) (*ChannelPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelPreference not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SaveChannelPreference(context.Context, *SaveChannelPreferenceReq) (*ChannelPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveChannelPreference not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetWebhookTargets(context.Context, *GetWebhookTargetsReq) (*WebhookTargets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookTargets not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SaveWebhookTarget(context.Context, *SaveWebhookTargetReq) (*WebhookTarget, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWebhookTarget not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) DeleteWebhookTarget(context.Context, *DeleteWebhookTargetReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookTarget not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesReq) (*WebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetNotificationDigestSettings(context.Context, *GetNotificationDigestSettingsReq) (*NotificationDigestSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationDigestSettings not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SaveNotificationDigestSettings(context.Context, *SaveNotificationDigestSettingsReq) (*NotificationDigestSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveNotificationDigestSettings not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) GetFrequencyCap(context.Context, *GetFrequencyCapReq) (*FrequencyCap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrequencyCap not implemented")
+}
+func (UnimplementedMessagingServiceApiServer) SaveFrequencyCap(context.Context, *SaveFrequencyCapReq) (*FrequencyCap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFrequencyCap not implemented")
+}
 func (UnimplementedMessagingServiceApiServer) mustEmbedUnimplementedMessagingServiceApiServer() {}
 
 // UnsafeMessagingServiceApiServer may be embedded to opt out of forward compatibility for this service.
@@ -301,6 +623,42 @@ func _MessagingServiceApi_SendMessageToStudyParticipants_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessagingServiceApi_PreviewMessageAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMessageAudienceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).PreviewMessageAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/PreviewMessageAudience",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).PreviewMessageAudience(ctx, req.(*PreviewMessageAudienceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SendTestEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SendTestEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SendTestEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SendTestEmail(ctx, req.(*SendTestEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MessagingServiceApi_GetAutoMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAutoMessagesReq)
 	if err := dec(in); err != nil {
@@ -355,6 +713,24 @@ func _MessagingServiceApi_DeleteAutoMessage_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessagingServiceApi_GetAutoMessageRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAutoMessageRunsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetAutoMessageRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetAutoMessageRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetAutoMessageRuns(ctx, req.(*GetAutoMessageRunsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MessagingServiceApi_GetEmailTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEmailTemplatesReq)
 	if err := dec(in); err != nil {
@@ -409,57 +785,509 @@ func _MessagingServiceApi_DeleteEmailTemplate_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// MessagingServiceApi_ServiceDesc is the grpc.ServiceDesc for MessagingServiceApi service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MessagingServiceApi_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "influenzanet.message_service.MessagingServiceApi",
-	HandlerType: (*MessagingServiceApiServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Status",
-			Handler:    _MessagingServiceApi_Status_Handler,
-		},
-		{
-			MethodName: "SendInstantEmail",
-			Handler:    _MessagingServiceApi_SendInstantEmail_Handler,
-		},
-		{
-			MethodName: "QueueEmailTemplateForSending",
-			Handler:    _MessagingServiceApi_QueueEmailTemplateForSending_Handler,
-		},
-		{
-			MethodName: "SendMessageToAllUsers",
-			Handler:    _MessagingServiceApi_SendMessageToAllUsers_Handler,
-		},
-		{
-			MethodName: "SendMessageToStudyParticipants",
-			Handler:    _MessagingServiceApi_SendMessageToStudyParticipants_Handler,
-		},
-		{
-			MethodName: "GetAutoMessages",
-			Handler:    _MessagingServiceApi_GetAutoMessages_Handler,
-		},
-		{
-			MethodName: "SaveAutoMessage",
-			Handler:    _MessagingServiceApi_SaveAutoMessage_Handler,
-		},
-		{
-			MethodName: "DeleteAutoMessage",
-			Handler:    _MessagingServiceApi_DeleteAutoMessage_Handler,
-		},
-		{
-			MethodName: "GetEmailTemplates",
-			Handler:    _MessagingServiceApi_GetEmailTemplates_Handler,
-		},
-		{
-			MethodName: "SaveEmailTemplate",
-			Handler:    _MessagingServiceApi_SaveEmailTemplate_Handler,
-		},
-		{
-			MethodName: "DeleteEmailTemplate",
+func _MessagingServiceApi_RenderEmailTemplatePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderEmailTemplatePreviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).RenderEmailTemplatePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/RenderEmailTemplatePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).RenderEmailTemplatePreview(ctx, req.(*RenderEmailTemplatePreviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetEmailTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmailTemplateVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetEmailTemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetEmailTemplateVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetEmailTemplateVersions(ctx, req.(*GetEmailTemplateVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetEmailTemplateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmailTemplateVersionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetEmailTemplateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetEmailTemplateVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetEmailTemplateVersion(ctx, req.(*GetEmailTemplateVersionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_RollbackEmailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackEmailTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).RollbackEmailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/RollbackEmailTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).RollbackEmailTemplate(ctx, req.(*RollbackEmailTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_PublishEmailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishEmailTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).PublishEmailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/PublishEmailTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).PublishEmailTemplate(ctx, req.(*PublishEmailTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetTemplatePartials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplatePartialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetTemplatePartials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetTemplatePartials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetTemplatePartials(ctx, req.(*GetTemplatePartialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SaveTemplatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveTemplatePartialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SaveTemplatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SaveTemplatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SaveTemplatePartial(ctx, req.(*SaveTemplatePartialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_DeleteTemplatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplatePartialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).DeleteTemplatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/DeleteTemplatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).DeleteTemplatePartial(ctx, req.(*DeleteTemplatePartialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SavePushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePushSubscriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SavePushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SavePushSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SavePushSubscription(ctx, req.(*SavePushSubscriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_DeletePushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePushSubscriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).DeletePushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/DeletePushSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).DeletePushSubscription(ctx, req.(*DeletePushSubscriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetChannelPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelPreferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetChannelPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetChannelPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetChannelPreference(ctx, req.(*GetChannelPreferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SaveChannelPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveChannelPreferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SaveChannelPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SaveChannelPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SaveChannelPreference(ctx, req.(*SaveChannelPreferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetWebhookTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookTargetsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetWebhookTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetWebhookTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetWebhookTargets(ctx, req.(*GetWebhookTargetsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SaveWebhookTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWebhookTargetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SaveWebhookTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SaveWebhookTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SaveWebhookTarget(ctx, req.(*SaveWebhookTargetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_DeleteWebhookTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookTargetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).DeleteWebhookTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/DeleteWebhookTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).DeleteWebhookTarget(ctx, req.(*DeleteWebhookTargetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetNotificationDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationDigestSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetNotificationDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetNotificationDigestSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetNotificationDigestSettings(ctx, req.(*GetNotificationDigestSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SaveNotificationDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveNotificationDigestSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SaveNotificationDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SaveNotificationDigestSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SaveNotificationDigestSettings(ctx, req.(*SaveNotificationDigestSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_GetFrequencyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrequencyCapReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).GetFrequencyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/GetFrequencyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).GetFrequencyCap(ctx, req.(*GetFrequencyCapReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessagingServiceApi_SaveFrequencyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFrequencyCapReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServiceApiServer).SaveFrequencyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.message_service.MessagingServiceApi/SaveFrequencyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServiceApiServer).SaveFrequencyCap(ctx, req.(*SaveFrequencyCapReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessagingServiceApi_ServiceDesc is the grpc.ServiceDesc for MessagingServiceApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessagingServiceApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "influenzanet.message_service.MessagingServiceApi",
+	HandlerType: (*MessagingServiceApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _MessagingServiceApi_Status_Handler,
+		},
+		{
+			MethodName: "SendInstantEmail",
+			Handler:    _MessagingServiceApi_SendInstantEmail_Handler,
+		},
+		{
+			MethodName: "QueueEmailTemplateForSending",
+			Handler:    _MessagingServiceApi_QueueEmailTemplateForSending_Handler,
+		},
+		{
+			MethodName: "SendMessageToAllUsers",
+			Handler:    _MessagingServiceApi_SendMessageToAllUsers_Handler,
+		},
+		{
+			MethodName: "SendMessageToStudyParticipants",
+			Handler:    _MessagingServiceApi_SendMessageToStudyParticipants_Handler,
+		},
+		{
+			MethodName: "PreviewMessageAudience",
+			Handler:    _MessagingServiceApi_PreviewMessageAudience_Handler,
+		},
+		{
+			MethodName: "SendTestEmail",
+			Handler:    _MessagingServiceApi_SendTestEmail_Handler,
+		},
+		{
+			MethodName: "GetAutoMessages",
+			Handler:    _MessagingServiceApi_GetAutoMessages_Handler,
+		},
+		{
+			MethodName: "SaveAutoMessage",
+			Handler:    _MessagingServiceApi_SaveAutoMessage_Handler,
+		},
+		{
+			MethodName: "DeleteAutoMessage",
+			Handler:    _MessagingServiceApi_DeleteAutoMessage_Handler,
+		},
+		{
+			MethodName: "GetAutoMessageRuns",
+			Handler:    _MessagingServiceApi_GetAutoMessageRuns_Handler,
+		},
+		{
+			MethodName: "GetEmailTemplates",
+			Handler:    _MessagingServiceApi_GetEmailTemplates_Handler,
+		},
+		{
+			MethodName: "SaveEmailTemplate",
+			Handler:    _MessagingServiceApi_SaveEmailTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteEmailTemplate",
 			Handler:    _MessagingServiceApi_DeleteEmailTemplate_Handler,
 		},
+		{
+			MethodName: "RenderEmailTemplatePreview",
+			Handler:    _MessagingServiceApi_RenderEmailTemplatePreview_Handler,
+		},
+		{
+			MethodName: "GetEmailTemplateVersions",
+			Handler:    _MessagingServiceApi_GetEmailTemplateVersions_Handler,
+		},
+		{
+			MethodName: "GetEmailTemplateVersion",
+			Handler:    _MessagingServiceApi_GetEmailTemplateVersion_Handler,
+		},
+		{
+			MethodName: "RollbackEmailTemplate",
+			Handler:    _MessagingServiceApi_RollbackEmailTemplate_Handler,
+		},
+		{
+			MethodName: "PublishEmailTemplate",
+			Handler:    _MessagingServiceApi_PublishEmailTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplatePartials",
+			Handler:    _MessagingServiceApi_GetTemplatePartials_Handler,
+		},
+		{
+			MethodName: "SaveTemplatePartial",
+			Handler:    _MessagingServiceApi_SaveTemplatePartial_Handler,
+		},
+		{
+			MethodName: "DeleteTemplatePartial",
+			Handler:    _MessagingServiceApi_DeleteTemplatePartial_Handler,
+		},
+		{
+			MethodName: "SavePushSubscription",
+			Handler:    _MessagingServiceApi_SavePushSubscription_Handler,
+		},
+		{
+			MethodName: "DeletePushSubscription",
+			Handler:    _MessagingServiceApi_DeletePushSubscription_Handler,
+		},
+		{
+			MethodName: "GetChannelPreference",
+			Handler:    _MessagingServiceApi_GetChannelPreference_Handler,
+		},
+		{
+			MethodName: "SaveChannelPreference",
+			Handler:    _MessagingServiceApi_SaveChannelPreference_Handler,
+		},
+		{
+			MethodName: "GetWebhookTargets",
+			Handler:    _MessagingServiceApi_GetWebhookTargets_Handler,
+		},
+		{
+			MethodName: "SaveWebhookTarget",
+			Handler:    _MessagingServiceApi_SaveWebhookTarget_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookTarget",
+			Handler:    _MessagingServiceApi_DeleteWebhookTarget_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _MessagingServiceApi_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "GetNotificationDigestSettings",
+			Handler:    _MessagingServiceApi_GetNotificationDigestSettings_Handler,
+		},
+		{
+			MethodName: "SaveNotificationDigestSettings",
+			Handler:    _MessagingServiceApi_SaveNotificationDigestSettings_Handler,
+		},
+		{
+			MethodName: "GetFrequencyCap",
+			Handler:    _MessagingServiceApi_GetFrequencyCap_Handler,
+		},
+		{
+			MethodName: "SaveFrequencyCap",
+			Handler:    _MessagingServiceApi_SaveFrequencyCap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "messaging_service/message-service.proto",
@@ -2,7 +2,7 @@ package bulk_messages
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -13,6 +13,7 @@ import (
 	"github.com/influenzanet/go-utils/pkg/api_types"
 	"github.com/influenzanet/go-utils/pkg/constants"
 	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/channels"
 	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
 	"github.com/influenzanet/messaging-service/pkg/templates"
 	"github.com/influenzanet/messaging-service/pkg/types"
@@ -32,9 +33,20 @@ func GenerateAutoMessages(
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
+
+	run := types.AutoMessageRun{
+		AutoMessageID: autoMessage.ID.Hex(),
+		Label:         messageLabel,
+		Type:          autoMessage.Type,
+		MessageType:   autoMessage.Template.MessageType,
+		StartedAt:     time.Now().Unix(),
+	}
+	counters := types.InitMessageCounter()
+	var err error
+
 	switch autoMessage.Type {
 	case "all-users":
-		GenerateForAllUsers(
+		counters, err = GenerateForAllUsers(
 			apiClients,
 			messageDBService,
 			instanceID,
@@ -44,11 +56,13 @@ func GenerateAutoMessages(
 		)
 	case "scheduled-participant-messages":
 		logger.Warning.Printf("using 'particpant messages' through auto-message schedules is deprecated, please remove this schedule, InstanceID: %v, StudyKey: %v, Message ID: %s", instanceID, autoMessage.StudyKey, autoMessage.ID)
+		err = errors.New("deprecated auto message type: " + autoMessage.Type)
 	case "researcher-notifications":
 		logger.Warning.Printf("using 'researcher notifications' through auto-message schedules is deprecated, please remove this schedule, InstanceID: %v, StudyKey: %v, Message ID: %s", instanceID, autoMessage.StudyKey, autoMessage.ID)
+		err = errors.New("deprecated auto message type: " + autoMessage.Type)
 	case "study-participants":
 		autoMessage.Template.StudyKey = autoMessage.StudyKey
-		GenerateForStudyParticipants(
+		counters, err = GenerateForStudyParticipants(
 			apiClients,
 			messageDBService,
 			instanceID,
@@ -59,6 +73,12 @@ func GenerateAutoMessages(
 		)
 	default:
 		logger.Error.Printf("GenerateAutoMessages: message type unknown: %s", autoMessage.Type)
+		err = errors.New("message type unknown: " + autoMessage.Type)
+	}
+
+	run.Finish(counters, err, time.Now().Unix())
+	if _, err := messageDBService.AddAutoMessageRun(instanceID, run); err != nil {
+		logger.Error.Printf("GenerateAutoMessages: could not save run of %s: %v", run.AutoMessageID, err)
 	}
 }
 
@@ -69,58 +89,55 @@ func GenerateForAllUsers(
 	messageTemplate types.EmailTemplate,
 	ignoreWeekday bool,
 	messageLabel string,
-) {
-	counters := types.InitMessageCounter()
+) (counters types.MessageCounter, err error) {
+	counters = types.InitMessageCounter()
 
 	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
+	partials := loadTemplatePartials(messageDBService, instanceID)
+	freqCap := loadFrequencyCap(messageDBService, instanceID)
 
 	currentWeekday := time.Now().Weekday()
 	stream, err := getFilteredUserStream(apiClients, instanceID, messageTemplate.MessageType, int32(currentWeekday), ignoreWeekday)
 	if err != nil {
 		logger.Error.Printf("GenerateForAllUsers: %v", err)
-		return
+		return counters, err
 	}
 
 	for {
-		user, err := stream.Recv()
-		if err == io.EOF {
+		user, errRecv := stream.Recv()
+		if errRecv == io.EOF {
 			break
 		}
-		if err != nil {
-			logger.Error.Printf("%v.GenerateForAllUsers(_) = _, %v", apiClients.UserManagementService, err)
+		if errRecv != nil {
+			logger.Error.Printf("%v.GenerateForAllUsers(_) = _, %v", apiClients.UserManagementService, errRecv)
+			err = errRecv
 			break
 		}
 
-		if !isSubscribed(user, messageTemplate.MessageType) {
-			continue
-		}
-
-		if !hasAccountType(user, "email") {
-			logger.Debug.Printf("skip user %s with account type %s", user.Id, user.Account.Type)
+		if !isEligibleRecipient(user, apiClients, instanceID, messageTemplate, nil, false) {
+			counters.IncreaseSkipped()
 			continue
 		}
 
-		contentInfos := map[string]string{}
-		for k, v := range globalTemplateInfos {
-			contentInfos[k] = v
-		}
-		outgoing, err := prepareOutgoingEmail(
+		contentInfos := templates.NewTemplateData(globalTemplateInfos)
+		if err := queueOutgoingMessage(
 			user,
 			apiClients,
 			messageDBService,
 			instanceID,
 			messageTemplate,
+			partials,
 			contentInfos,
 			messageTemplate.MessageType == constants.EMAIL_TYPE_WEEKLY || messageTemplate.MessageType == constants.EMAIL_TYPE_STUDY_REMINDER,
-		)
-		if err != nil {
-			counters.IncreaseCounter(false)
-			logger.Error.Printf("unexpected error: %v", err)
+			types.BulkMessagePriority(messageTemplate.MessageType),
+			freqCap,
+			&counters,
+		); errors.Is(err, errNoChannel) {
+			counters.IncreaseSkipped()
 			continue
-		}
-
-		_, err = messageDBService.AddToOutgoingEmails(instanceID, *outgoing)
-		if err != nil {
+		} else if errors.Is(err, errCapped) {
+			continue
+		} else if err != nil {
 			counters.IncreaseCounter(false)
 			logger.Error.Printf("unexpected error: %v", err)
 			continue
@@ -128,7 +145,8 @@ func GenerateForAllUsers(
 		counters.IncreaseCounter(true)
 	}
 	counters.Stop()
-	logger.Info.Printf("Generated %d (%d failed) '%s' messages in %d s for %s for %s.", counters.Total, counters.Failed, messageTemplate.MessageType, counters.Duration, messageLabel, instanceID)
+	logger.Info.Printf("Generated %d (%d failed, %d in default language) '%s' messages in %d s for %s for %s.", counters.Total, counters.Failed, counters.LanguageFallbacks, messageTemplate.MessageType, counters.Duration, messageLabel, instanceID)
+	return counters, err
 }
 
 func GenerateForStudyParticipants(
@@ -139,68 +157,55 @@ func GenerateForStudyParticipants(
 	condition *api.ExpressionArg,
 	ignoreWeekday bool,
 	messageLabel string,
-) {
-	counters := types.InitMessageCounter()
+) (counters types.MessageCounter, err error) {
+	counters = types.InitMessageCounter()
 
 	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
+	partials := loadTemplatePartials(messageDBService, instanceID)
+	freqCap := loadFrequencyCap(messageDBService, instanceID)
 
 	currentWeekday := time.Now().Weekday()
 	stream, err := getFilteredUserStream(apiClients, instanceID, messageTemplate.MessageType, int32(currentWeekday), ignoreWeekday)
 	if err != nil {
 		logger.Error.Printf("%v", err)
-		return
+		return counters, err
 	}
 
 	for {
-		user, err := stream.Recv()
-		if err == io.EOF {
+		user, errRecv := stream.Recv()
+		if errRecv == io.EOF {
 			break
 		}
-		if err != nil {
-			logger.Error.Printf("%v", err)
+		if errRecv != nil {
+			logger.Error.Printf("%v", errRecv)
+			err = errRecv
 			break
 		}
 
-		if !isSubscribed(user, messageTemplate.MessageType) {
-			continue
-		}
-
-		if !hasAccountType(user, "email") {
-			logger.Debug.Printf("skip user %s with account type %s", user.Id, user.Account.Type)
+		if !isEligibleRecipient(user, apiClients, instanceID, messageTemplate, condition, true) {
+			counters.IncreaseSkipped()
 			continue
 		}
 
-		if err = checkStudyStateForUser(
-			user,
-			apiClients,
-			instanceID,
-			messageTemplate.StudyKey,
-			condition,
-		); err != nil {
-			continue
-		}
-
-		contentInfos := map[string]string{}
-		for k, v := range globalTemplateInfos {
-			contentInfos[k] = v
-		}
-		outgoing, err := prepareOutgoingEmail(
+		contentInfos := templates.NewTemplateData(globalTemplateInfos)
+		if err := queueOutgoingMessage(
 			user,
 			apiClients,
 			messageDBService,
 			instanceID,
 			messageTemplate,
+			partials,
 			contentInfos,
 			true,
-		)
-		if err != nil {
-			counters.IncreaseCounter(false)
-			logger.Error.Printf("unexpected error: %v", err)
+			types.BulkMessagePriority(messageTemplate.MessageType),
+			freqCap,
+			&counters,
+		); errors.Is(err, errNoChannel) {
+			counters.IncreaseSkipped()
 			continue
-		}
-
-		_, err = messageDBService.AddToOutgoingEmails(instanceID, *outgoing)
-		if err != nil {
+		} else if errors.Is(err, errCapped) {
+			continue
+		} else if err != nil {
 			counters.IncreaseCounter(false)
 			logger.Error.Printf("unexpected error: %v", err)
 			continue
@@ -208,14 +213,19 @@ func GenerateForStudyParticipants(
 		counters.IncreaseCounter(true)
 	}
 	counters.Stop()
-	logger.Info.Printf("Generated %d (%d failed) '%s' messages in %d s for %s for %s.", counters.Total, counters.Failed, messageTemplate.MessageType, counters.Duration, messageLabel, instanceID)
+	logger.Info.Printf("Generated %d (%d failed, %d in default language) '%s' messages in %d s for %s for %s.", counters.Total, counters.Failed, counters.LanguageFallbacks, messageTemplate.MessageType, counters.Duration, messageLabel, instanceID)
+	return counters, err
 }
 
+// GenerateParticipantMessages sends the pending participant messages of the study service. With batchMessages, the
+// messages of a user with more than one pending message are combined into one message of the
+// EMAIL_TYPE_PARTICIPANT_MESSAGES_BATCH template, if the instance has one.
 func GenerateParticipantMessages(
 	apiClients *types.APIClients,
 	messageDBService *messagedb.MessageDBService,
 	instanceID string,
 	messageLabel string,
+	batchMessages bool,
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
@@ -231,6 +241,8 @@ func GenerateParticipantMessages(
 	counters := types.InitMessageCounter()
 
 	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
+	partials := loadTemplatePartials(messageDBService, instanceID)
+	freqCap := loadFrequencyCap(messageDBService, instanceID)
 
 	currentWeekday := time.Now().Weekday()
 	ignoreWeekday := true
@@ -240,6 +252,16 @@ func GenerateParticipantMessages(
 		return
 	}
 
+	var batchTemplate *types.EmailTemplate
+	if batchMessages {
+		t, err := messageDBService.FindEmailTemplateByType(instanceID, types.EMAIL_TYPE_PARTICIPANT_MESSAGES_BATCH, "")
+		if err != nil {
+			logger.Warning.Printf("template for '%s' could not be found, participant messages are sent one by one. [%s]", types.EMAIL_TYPE_PARTICIPANT_MESSAGES_BATCH, instanceID)
+		} else {
+			batchTemplate = &t
+		}
+	}
+
 	messageTemplateCache := map[string]types.EmailTemplate{}
 	sentMessageCountByType := map[string]int{}
 	for {
@@ -252,106 +274,112 @@ func GenerateParticipantMessages(
 			break
 		}
 
-		if !hasAccountType(user, "email") {
+		if !hasAccountType(user, types.ACCOUNT_TYPE_EMAIL) && !hasAccountType(user, types.ACCOUNT_TYPE_PHONE) {
 			logger.Debug.Printf("skip user %s with account type %s", user.Id, user.Account.Type)
 			continue
 		}
 
-		for _, profile := range user.Profiles {
-			studiesForUser, err := apiClients.StudyService.GetStudiesForUser(context.Background(), &studyAPI.GetStudiesForUserReq{
-				Token: &api_types.TokenInfos{
-					Id:         user.Id,
-					InstanceId: instanceID,
-					ProfilId:   profile.Id,
-				},
-			})
-			if err != nil {
-				logger.Debug.Printf("%s - %s: %v", instanceID, profile.Id, err)
+		pending := fetchPendingParticipantMessages(apiClients, instanceID, user)
+		if len(pending) == 0 {
+			continue
+		}
+
+		if batchTemplate != nil && len(pending) > 1 && canReceive(user, *batchTemplate) {
+			contentInfos, err := participantBatchTemplateData(globalTemplateInfos, pending)
+			if err == nil {
+				err = queueOutgoingMessage(
+					user,
+					apiClients,
+					messageDBService,
+					instanceID,
+					*batchTemplate,
+					partials,
+					contentInfos,
+					true,
+					types.PRIORITY_STUDY_CRITICAL,
+					freqCap,
+					&counters,
+				)
+			}
+			if err == nil {
+				counters.IncreaseCounter(true)
+				for _, p := range pending {
+					sentMessageCountByType[p.message.Type] += 1
+				}
+				deleteParticipantMessages(apiClients, instanceID, pending)
+				continue
+			}
+			if errors.Is(err, errCapped) {
+				// dropped, as the messages would be when sent one by one
+				deleteParticipantMessages(apiClients, instanceID, pending)
 				continue
 			}
-			for _, study := range studiesForUser.GetStudies() {
-				resp, err := apiClients.StudyService.GetParticipantMessages(context.Background(), &studyAPI.GetParticipantMessagesReq{
-					InstanceId: instanceID,
-					StudyKey:   study.Key,
-					ProfileId:  profile.Id,
-				})
+			if !errors.Is(err, errNoChannel) {
+				// kept for the next run
+				counters.IncreaseCounter(false)
+				logger.Error.Printf("batch of %d messages for %s: %v [%s]", len(pending), user.Id, err, instanceID)
+				continue
+			}
+		}
+
+		sentMessages := []pendingParticipantMessage{}
+		for _, p := range pending {
+			m := p.message
+			template, ok := messageTemplateCache[m.Type]
+			if !ok {
+				template, err = messageDBService.FindEmailTemplateByType(instanceID, m.Type, p.studyKey)
 				if err != nil {
-					// log needed only in debug mode, to prevent too much errors when profile is not a participant
-					logger.Debug.Printf("%s - %s - %s: %v", instanceID, study.Key, profile.Id, err)
+					counters.IncreaseCounter(false)
+					logger.Error.Printf("template for '%s' could not be found. [%s:%s]", m.Type, instanceID, p.studyKey)
 					continue
 				}
+				messageTemplateCache[m.Type] = template
+			}
+			if !canReceive(user, template) {
+				// kept for the participant until one of the template's channels is available
+				counters.IncreaseSkipped()
+				continue
+			}
 
-				sentMessages := []string{}
-				for _, m := range resp.Messages {
-					template, ok := messageTemplateCache[m.Type]
-					if !ok {
-						template, err = messageDBService.FindEmailTemplateByType(instanceID, m.Type, study.Key)
-						if err != nil {
-							counters.IncreaseCounter(false)
-							logger.Error.Printf("template for '%s' could not be found. [%s:%s]", m.Type, instanceID, study.Key)
-							continue
-						}
-						messageTemplateCache[m.Type] = template
-					}
-
-					contentInfos := map[string]string{}
-					for k, v := range globalTemplateInfos {
-						contentInfos[k] = v
-					}
-					contentInfos["profileAlias"] = profile.Alias
-					contentInfos["profileId"] = profile.Id
-					// make payload accessible for the template eninge:
-					for k, v := range m.Payload {
-						contentInfos[k] = v
-					}
-					outgoing, err := prepareOutgoingEmail(
-						user,
-						apiClients,
-						messageDBService,
-						instanceID,
-						template,
-						contentInfos,
-						true,
-					)
-					if err != nil {
-						counters.IncreaseCounter(false)
-						logger.Error.Printf("unexpected error: %v", err)
-						continue
-					}
-
-					_, err = messageDBService.AddToOutgoingEmails(instanceID, *outgoing)
-					if err != nil {
-						counters.IncreaseCounter(false)
-						logger.Error.Printf("unexpected error: %v", err)
-						continue
-					}
-					counters.IncreaseCounter(true)
-
-					_, ok = sentMessageCountByType[m.Type]
-					if !ok {
-						sentMessageCountByType[m.Type] = 1
-					} else {
-						sentMessageCountByType[m.Type] += 1
-					}
-
-					sentMessages = append(sentMessages, m.Id)
-				}
-
-				// delete messages when generated:
-				if len(sentMessages) > 0 {
-					_, err = apiClients.StudyService.DeleteMessagesFromParticipant(context.Background(), &studyAPI.DeleteMessagesFromParticipantReq{
-						InstanceId: instanceID,
-						StudyKey:   study.Key,
-						ProfileId:  profile.Id,
-						MessageIds: sentMessages,
-					})
-					if err != nil {
-						logger.Error.Printf("unexpected error: %v", err)
-						continue
-					}
-				}
+			contentInfos := templates.NewTemplateData(globalTemplateInfos)
+			contentInfos["profileAlias"] = p.profile.Alias
+			contentInfos["profileId"] = p.profile.Id
+			// make payload accessible for the template eninge:
+			if err := contentInfos.AddPayload(m.Payload); err != nil {
+				counters.IncreaseCounter(false)
+				logger.Error.Printf("message '%s' for %s: %v [%s:%s]", m.Type, p.profile.Id, err, instanceID, p.studyKey)
+				continue
+			}
+			if err := queueOutgoingMessage(
+				user,
+				apiClients,
+				messageDBService,
+				instanceID,
+				template,
+				partials,
+				contentInfos,
+				true,
+				types.PRIORITY_STUDY_CRITICAL,
+				freqCap,
+				&counters,
+			); errors.Is(err, errNoChannel) {
+				counters.IncreaseSkipped()
+				continue
+			} else if errors.Is(err, errCapped) {
+				sentMessages = append(sentMessages, p)
+				continue
+			} else if err != nil {
+				counters.IncreaseCounter(false)
+				logger.Error.Printf("unexpected error: %v", err)
+				continue
 			}
+			counters.IncreaseCounter(true)
+			sentMessageCountByType[m.Type] += 1
+			sentMessages = append(sentMessages, p)
 		}
+
+		// delete messages when generated or dropped by the frequency cap:
+		deleteParticipantMessages(apiClients, instanceID, sentMessages)
 	}
 	counters.Stop()
 
@@ -365,7 +393,7 @@ func GenerateParticipantMessages(
 		msgCountsLog = "none"
 	}
 
-	logger.Info.Printf("Generated %d (%d failed) '%s' messages in %ds for %s. Message counts: [%s]", counters.Total, counters.Failed, "scheduled participant", counters.Duration, messageLabel, msgCountsLog)
+	logger.Info.Printf("Generated %d (%d failed, %d in default language) '%s' messages in %ds for %s. Message counts: [%s]", counters.Total, counters.Failed, counters.LanguageFallbacks, "scheduled participant", counters.Duration, messageLabel, msgCountsLog)
 }
 
 func GenerateResearcherNotificationMessages(
@@ -379,8 +407,10 @@ func GenerateResearcherNotificationMessages(
 	counters := types.InitMessageCounter()
 
 	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
+	partials := loadTemplatePartials(messageDBService, instanceID)
 
 	messageTemplateCache := map[string]types.EmailTemplate{}
+	digestModeCache := map[string]string{}
 
 	messages, err := apiClients.StudyService.GetResearcherMessages(context.Background(), &studyAPI.GetReseacherMessagesReq{InstanceId: instanceID})
 	if err != nil {
@@ -389,56 +419,69 @@ func GenerateResearcherNotificationMessages(
 	}
 
 	for _, m := range messages.Messages {
-		template, ok := messageTemplateCache[m.Type+m.StudyKey]
-		if !ok {
-			template, err = messageDBService.FindEmailTemplateByType(instanceID, m.Type, m.StudyKey)
-			if err != nil {
-				counters.IncreaseCounter(false)
-				logger.Error.Printf("template for '%s' could not be found. [%s:%s]", m.Type, instanceID, m.StudyKey)
-				continue
-			}
-			messageTemplateCache[m.Type+m.StudyKey] = template
+		// recipients the notification was queued for in an earlier run, if it was kept for retrying
+		handled, err := messageDBService.FindHandledNotificationRecipients(instanceID, m.Id)
+		if err != nil {
+			logger.Error.Printf("researcher notification '%s' could not be checked: %v [%s:%s]", m.Type, err, instanceID, m.StudyKey)
+			continue
 		}
+		retried := len(handled) > 0
 
-		contentInfos := map[string]string{}
-		for k, v := range globalTemplateInfos {
-			contentInfos[k] = v
-		}
-		contentInfos["participantID"] = m.ParticipantId
-		// Merge payload with content infos:
-		for k, v := range m.Payload {
-			contentInfos[k] = v
+		emailRecipients := []string{}
+		webhookRecipients := []string{}
+		for _, sendTo := range m.SendTo {
+			if handled[sendTo] {
+				continue
+			}
+			if _, ok := types.WebhookTargetName(sendTo); ok {
+				webhookRecipients = append(webhookRecipients, sendTo)
+			} else {
+				emailRecipients = append(emailRecipients, sendTo)
+			}
 		}
 
-		for _, sendTo := range m.SendTo {
-			user := &umAPI.User{
-				Account: &umAPI.User_Account{
-					AccountId: sendTo,
-					Type:      "email",
-				},
+		queued := []string{}
+		if len(emailRecipients) > 0 && collectsDigest(messageDBService, instanceID, m.StudyKey, digestModeCache) {
+			for _, sendTo := range emailRecipients {
+				if err := addToDigest(messageDBService, instanceID, sendTo, m); err != nil {
+					counters.IncreaseCounter(false)
+					logger.Error.Printf("researcher notification '%s' for digest of %s: %v [%s:%s]", m.Type, sendTo, err, instanceID, m.StudyKey)
+					continue
+				}
+				counters.IncreaseCounter(true)
+				queued = append(queued, sendTo)
 			}
-			outgoing, err := prepareOutgoingEmail(
-				user,
-				apiClients,
-				messageDBService,
-				instanceID,
-				template,
-				contentInfos,
-				false,
-			)
+		} else if len(emailRecipients) > 0 {
+			queuedEmails, err := queueResearcherNotificationEmails(apiClients, messageDBService, instanceID, m, emailRecipients, globalTemplateInfos, partials, messageTemplateCache, &counters)
 			if err != nil {
 				counters.IncreaseCounter(false)
-				logger.Error.Printf("unexpected error: %v", err)
-				continue
+				logger.Error.Printf("researcher notification '%s': %v [%s:%s]", m.Type, err, instanceID, m.StudyKey)
 			}
+			queued = append(queued, queuedEmails...)
+		}
 
-			_, err = messageDBService.AddToOutgoingEmails(instanceID, *outgoing)
-			if err != nil {
+		for _, sendTo := range webhookRecipients {
+			targetName, _ := types.WebhookTargetName(sendTo)
+			if err := queueWebhookNotification(messageDBService, instanceID, targetName, m); err != nil {
 				counters.IncreaseCounter(false)
-				logger.Error.Printf("unexpected error: %v", err)
+				logger.Error.Printf("researcher notification '%s' for webhook '%s': %v [%s:%s]", m.Type, targetName, err, instanceID, m.StudyKey)
 				continue
 			}
 			counters.IncreaseCounter(true)
+			queued = append(queued, sendTo)
+		}
+
+		for _, sendTo := range queued {
+			handled[sendTo] = true
+		}
+		if !allRecipientsHandled(m.SendTo, handled) {
+			// keep the message to retry the failed recipients, the others are not sent to again
+			for _, sendTo := range queued {
+				if err := messageDBService.AddHandledNotificationRecipient(instanceID, m.Id, sendTo); err != nil {
+					logger.Error.Printf("unexpected error when recording the recipient of a notification: %v", err)
+				}
+			}
+			continue
 		}
 
 		// delete message when generated:
@@ -449,6 +492,12 @@ func GenerateResearcherNotificationMessages(
 		})
 		if err != nil {
 			logger.Error.Printf("unexpected error when removing notification: %v", err)
+			continue
+		}
+		if retried {
+			if err := messageDBService.DeleteHandledNotificationRecipients(instanceID, m.Id); err != nil {
+				logger.Error.Printf("unexpected error when removing the recipients of a notification: %v", err)
+			}
 		}
 	}
 
@@ -456,32 +505,214 @@ func GenerateResearcherNotificationMessages(
 	logger.Info.Printf("Generated %d (%d failed) '%s' messages in %d s for auto email '%s'.", counters.Total, counters.Failed, "researcher notifications", counters.Duration, messageLabel)
 }
 
+// allRecipientsHandled checks if the notification was queued for each of its recipients
+func allRecipientsHandled(sendTo []string, handled map[string]bool) bool {
+	for _, r := range sendTo {
+		if !handled[r] {
+			return false
+		}
+	}
+	return true
+}
+
+// queueResearcherNotificationEmails adds the emails of a researcher notification to the outgoing queue, and returns the
+// recipients it is done with: queued, or not reachable by email. Returns an error if the template or the payload cannot
+// be used.
+func queueResearcherNotificationEmails(
+	apiClients *types.APIClients,
+	messageDBService *messagedb.MessageDBService,
+	instanceID string,
+	m *studyAPI.StudyMessage,
+	emailRecipients []string,
+	globalTemplateInfos map[string]string,
+	partials []types.TemplatePartial,
+	messageTemplateCache map[string]types.EmailTemplate,
+	counters *types.MessageCounter,
+) ([]string, error) {
+	handled := []string{}
+	template, ok := messageTemplateCache[m.Type+m.StudyKey]
+	if !ok {
+		var err error
+		template, err = messageDBService.FindEmailTemplateByType(instanceID, m.Type, m.StudyKey)
+		if err != nil {
+			return handled, errors.New("template could not be found")
+		}
+		messageTemplateCache[m.Type+m.StudyKey] = template
+	}
+
+	contentInfos := templates.NewTemplateData(globalTemplateInfos)
+	contentInfos["participantID"] = m.ParticipantId
+	// Merge payload with content infos:
+	if err := contentInfos.AddPayload(m.Payload); err != nil {
+		return handled, err
+	}
+
+	for _, sendTo := range emailRecipients {
+		user := &umAPI.User{
+			Account: &umAPI.User_Account{
+				AccountId: sendTo,
+				Type:      types.ACCOUNT_TYPE_EMAIL,
+			},
+		}
+		if err := queueOutgoingMessage(
+			user,
+			apiClients,
+			messageDBService,
+			instanceID,
+			template,
+			partials,
+			contentInfos,
+			false,
+			types.PRIORITY_STUDY_CRITICAL,
+			nil,
+			counters,
+		); errors.Is(err, errNoChannel) {
+			counters.IncreaseSkipped()
+			handled = append(handled, sendTo)
+			continue
+		} else if err != nil {
+			counters.IncreaseCounter(false)
+			logger.Error.Printf("unexpected error: %v", err)
+			continue
+		}
+		counters.IncreaseCounter(true)
+		handled = append(handled, sendTo)
+	}
+	return handled, nil
+}
+
+// queueOutgoingMessage prepares the message for the channel chosen by the routing rule of the template and adds it to
+// its outgoing queue. Without routing rule, push notifications are added for the browsers the user subscribed with.
+// Returns errNoChannel if the user cannot be reached, and errCapped if the email is dropped by the frequency cap, which
+// is only applied if freqCap is set.
+func queueOutgoingMessage(
+	user *umAPI.User,
+	apiClients *types.APIClients,
+	messageDBService *messagedb.MessageDBService,
+	instanceID string,
+	messageTemplate types.EmailTemplate,
+	partials []types.TemplatePartial,
+	contentInfos templates.TemplateData,
+	includeLoginToken bool,
+	priority string,
+	freqCap *frequencyCap,
+	counters *types.MessageCounter,
+) error {
+	findPushSubscriptions := func(userID string) ([]types.PushSubscription, error) {
+		return messageDBService.FindPushSubscriptions(instanceID, userID)
+	}
+	route, ok := chooseRoute(user, messageTemplate, channelPreferenceLookup(messageDBService, instanceID), findPushSubscriptions)
+	if !ok {
+		return errNoChannel
+	}
+
+	switch route.channel {
+	case channels.CHANNEL_SMS:
+		outgoing, err := prepareOutgoingSMS(user, route.to, apiClients, instanceID, messageTemplate, contentInfos, includeLoginToken, counters)
+		if err != nil {
+			return err
+		}
+		_, err = messageDBService.AddToOutgoingSMS(instanceID, *outgoing)
+		return err
+	case channels.CHANNEL_PUSH:
+		if err := addUserTokens(user, apiClients, instanceID, messageTemplate, contentInfos, includeLoginToken); err != nil {
+			return err
+		}
+		contentInfos["language"] = user.Account.PreferredLanguage
+		return queuePushNotifications(user, route.subscriptions, messageDBService, instanceID, messageTemplate, contentInfos, counters, true)
+	}
+
+	outgoing, err := prepareOutgoingEmail(user, route.to, apiClients, messageDBService, instanceID, messageTemplate, partials, contentInfos, includeLoginToken, counters)
+	if err != nil {
+		return err
+	}
+	// capped by the final recipients, e.g. newsletters can be redirected to other addresses
+	sendAfter, err := freqCap.apply(messageTemplate.MessageType, outgoing.To, counters)
+	if err != nil {
+		return err
+	}
+	outgoing.SendAfter = sendAfter
+	outgoing.SetPriority(priority)
+	if _, err := messageDBService.AddToOutgoingEmails(instanceID, *outgoing); err != nil {
+		return err
+	}
+
+	if len(messageTemplate.Channels) == 0 && messageTemplate.HasPushVariant() && user.Id != "" {
+		// in addition to the email, which is counted
+		subs, err := findPushSubscriptions(user.Id)
+		if err == nil {
+			err = queuePushNotifications(user, subs, messageDBService, instanceID, messageTemplate, contentInfos, counters, false)
+		}
+		if err != nil {
+			logger.Error.Printf("push notifications for user %s could not be queued: %v", user.Id, err)
+		}
+	}
+	return nil
+}
+
 func prepareOutgoingEmail(
 	user *umAPI.User,
+	to string,
 	apiClients *types.APIClients,
 	messageDBService *messagedb.MessageDBService,
 	instanceID string,
 	messageTemplate types.EmailTemplate,
-	contentInfos map[string]string,
+	partials []types.TemplatePartial,
+	contentInfos templates.TemplateData,
 	includeLoginToken bool,
+	counters *types.MessageCounter,
 ) (*types.OutgoingEmail, error) {
 	outgoingEmail := types.OutgoingEmail{
 		MessageType:     messageTemplate.MessageType,
 		HeaderOverrides: messageTemplate.HeaderOverrides,
 		AddedAt:         time.Now().Unix(),
+		TemplateVersion: messageTemplate.Version,
+		Channel:         channels.CHANNEL_EMAIL,
 	}
 
-	if user.Account.Type == "email" {
-		outgoingEmail.To = []string{user.Account.AccountId}
-	} else {
-		return nil, fmt.Errorf("account type not supported yet: %s", user.Account.Type)
+	outgoingEmail.To = emailRecipients(user, messageTemplate.MessageType, to)
+	if err := addUserTokens(user, apiClients, instanceID, messageTemplate, contentInfos, includeLoginToken); err != nil {
+		return nil, err
 	}
 
+	contentInfos["language"] = user.Account.PreferredLanguage
+	email, usedDefaultLanguage, err := generateEmailContent(instanceID, messageTemplate, partials, user.Account.PreferredLanguage, contentInfos)
+	if err != nil {
+		return nil, err
+	}
+	if usedDefaultLanguage {
+		counters.IncreaseLanguageFallbacks()
+		logLanguageFallback(counters, instanceID, messageTemplate, "translation", user.Account.PreferredLanguage)
+	}
+
+	outgoingEmail.Subject = email.Subject
+	outgoingEmail.Content = email.Content
+	outgoingEmail.TextContent = email.TextContent
+	return &outgoingEmail, nil
+}
+
+// emailRecipients returns the final recipients of an email to the address: newsletters are sent to the addresses
+// of the user's contact preferences
+func emailRecipients(user *umAPI.User, messageType string, to string) []string {
+	if messageType == constants.EMAIL_TYPE_NEWSLETTER {
+		return getEmailsByIds(user.ContactInfos, user.ContactPreferences.SendNewsletterTo)
+	}
+	return []string{to}
+}
+
+// addUserTokens adds the unsubscribe token of newsletters and optionally a temporary login token to the content infos
+func addUserTokens(
+	user *umAPI.User,
+	apiClients *types.APIClients,
+	instanceID string,
+	messageTemplate types.EmailTemplate,
+	contentInfos templates.TemplateData,
+	includeLoginToken bool,
+) error {
 	if messageTemplate.MessageType == constants.EMAIL_TYPE_NEWSLETTER {
-		outgoingEmail.To = getEmailsByIds(user.ContactInfos, user.ContactPreferences.SendNewsletterTo)
 		token, err := getUnsubscribeToken(apiClients.UserManagementService, instanceID, user)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		contentInfos["unsubscribeToken"] = token
 	}
@@ -489,21 +720,45 @@ func prepareOutgoingEmail(
 	if includeLoginToken {
 		token, err := getTemploginToken(apiClients.UserManagementService, instanceID, user, messageTemplate.StudyKey, loginTokenLifeTime)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		contentInfos["loginToken"] = token
 		contentInfos["studyKey"] = messageTemplate.StudyKey
 	}
+	return nil
+}
 
-	contentInfos["language"] = user.Account.PreferredLanguage
-	subject, content, err := generateEmailContent(messageTemplate, user.Account.PreferredLanguage, contentInfos)
-	if err != nil {
-		return nil, err
+// isEligibleRecipient applies the filters used for "all-users" and "study-participants" messages
+// (subscription, account type and optionally the study state)
+func isEligibleRecipient(
+	user *umAPI.User,
+	apiClients *types.APIClients,
+	instanceID string,
+	messageTemplate types.EmailTemplate,
+	condition *api.ExpressionArg,
+	checkStudyState bool,
+) bool {
+	if !isSubscribed(user, messageTemplate.MessageType) {
+		return false
 	}
 
-	outgoingEmail.Subject = subject
-	outgoingEmail.Content = content
-	return &outgoingEmail, nil
+	if !canReceive(user, messageTemplate) {
+		logger.Debug.Printf("skip user %s with account type %s", user.Id, user.Account.Type)
+		return false
+	}
+
+	if checkStudyState {
+		if err := checkStudyStateForUser(
+			user,
+			apiClients,
+			instanceID,
+			messageTemplate.StudyKey,
+			condition,
+		); err != nil {
+			return false
+		}
+	}
+	return true
 }
 
 func checkStudyStateForUser(
@@ -630,23 +885,50 @@ func getEmailsByIds(contacts []*umAPI.ContactInfo, ids []string) []string {
 	return emails
 }
 
-func generateEmailContent(temp types.EmailTemplate, prefLang string, contentInfos map[string]string) (subject string, content string, err error) {
-	translation := templates.GetTemplateTranslation(temp, prefLang)
-	subject = translation.Subject
-	decodedTemplate, err := base64.StdEncoding.DecodeString(translation.TemplateDef)
+// logLanguageFallback warns that the default language of the template is used instead of lang, once per template,
+// variant and language of the run
+func logLanguageFallback(counters *types.MessageCounter, instanceID string, tDef types.EmailTemplate, variant string, lang string) {
+	if !counters.FirstLanguageFallback(tDef.MessageType + ":" + tDef.StudyKey + ":" + variant + ":" + lang) {
+		return
+	}
+	logger.Warning.Printf("no %s of template '%s' for language '%s', using default language '%s' [%s:%s]", variant, tDef.MessageType, lang, tDef.DefaultLanguage, instanceID, tDef.StudyKey)
+}
+
+// generateEmailContent renders the translation for prefLang, usedDefaultLanguage is set if it is not available
+func generateEmailContent(
+	instanceID string,
+	temp types.EmailTemplate,
+	partials []types.TemplatePartial,
+	prefLang string,
+	contentInfos templates.TemplateData,
+) (email templates.EmailContent, usedDefaultLanguage bool, err error) {
+	translation, usedDefaultLanguage := templates.SelectTemplateTranslation(instanceID, temp, prefLang)
+	shared, err := templates.NewSharedTemplates(instanceID, partials, temp.Layout, prefLang)
 	if err != nil {
-		return "", "", err
+		return templates.EmailContent{}, usedDefaultLanguage, err
 	}
 
 	// execute template
-	content, err = templates.ResolveTemplate(
+	email, err = templates.ResolveTranslation(
 		temp.MessageType+prefLang,
-		string(decodedTemplate),
+		temp,
+		translation,
+		shared,
 		contentInfos,
 	)
 	return
 }
 
+// loadTemplatePartials fetches the partials once per run, messages are generated without them if this fails
+func loadTemplatePartials(messageDBService *messagedb.MessageDBService, instanceID string) []types.TemplatePartial {
+	partials, err := messageDBService.FindAllTemplatePartials(instanceID)
+	if err != nil {
+		logger.Error.Printf("template partials could not be loaded for %s: %v", instanceID, err)
+		return nil
+	}
+	return partials
+}
+
 func getTemploginToken(
 	userClient umAPI.UserManagementApiClient,
 	instanceID string,
@@ -0,0 +1,199 @@
+package bulk_messages
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	studyAPI "github.com/influenzanet/study-service/pkg/api"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// collectsDigest checks if researcher notifications of the study are collected for a digest, the modes are cached
+// per run. Studies without settings get their notifications immediately.
+func collectsDigest(messageDBService *messagedb.MessageDBService, instanceID string, studyKey string, modeCache map[string]string) bool {
+	mode, ok := modeCache[studyKey]
+	if !ok {
+		settings, err := messageDBService.FindNotificationDigestSettings(instanceID, studyKey)
+		if err == nil {
+			mode = settings.Mode
+		}
+		modeCache[studyKey] = mode
+	}
+	return types.DigestInterval(mode) > 0
+}
+
+func addToDigest(messageDBService *messagedb.MessageDBService, instanceID string, recipient string, m *studyAPI.StudyMessage) error {
+	_, err := messageDBService.AddNotificationDigestEntry(instanceID, types.NotificationDigestEntry{
+		StudyKey:       m.StudyKey,
+		Recipient:      recipient,
+		MessageType:    m.Type,
+		NotificationID: m.Id,
+		ParticipantID:  m.ParticipantId,
+		Payload:        m.Payload,
+		CreatedAt:      time.Now().Unix(),
+	})
+	return err
+}
+
+// GenerateNotificationDigests sends one email per recipient with the collected researcher notifications of each
+// study whose digest is due. Entries are removed once their email is queued, failed ones are kept for the next digest.
+// Entries of studies switched back to immediate are sent on the next run.
+func GenerateNotificationDigests(
+	apiClients *types.APIClients,
+	messageDBService *messagedb.MessageDBService,
+	instanceID string,
+	messageLabel string,
+	wg *sync.WaitGroup,
+) {
+	defer wg.Done()
+	counters := types.InitMessageCounter()
+
+	studies, err := messageDBService.FindAllNotificationDigestSettings(instanceID)
+	if err != nil {
+		logger.Error.Printf("notification digest settings could not be loaded for %s: %v", instanceID, err)
+		return
+	}
+
+	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
+	partials := loadTemplatePartials(messageDBService, instanceID)
+
+	now := time.Now()
+	for _, settings := range studies {
+		if !settings.IsDue(now) {
+			continue
+		}
+		entries, err := messageDBService.FindNotificationDigestEntries(instanceID, settings.StudyKey)
+		if err != nil {
+			logger.Error.Printf("notification digest entries could not be loaded: %v [%s:%s]", err, instanceID, settings.StudyKey)
+			continue
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		template, err := findDigestTemplate(messageDBService, instanceID, settings.StudyKey)
+		if err != nil {
+			logger.Error.Printf("template for '%s' could not be found. [%s:%s]", types.EMAIL_TYPE_NOTIFICATION_DIGEST, instanceID, settings.StudyKey)
+			continue
+		}
+
+		sentEntries := []types.NotificationDigestEntry{}
+		recipients, entriesByRecipient := groupDigestEntries(entries)
+		for _, recipient := range recipients {
+			recipientEntries := entriesByRecipient[recipient]
+			contentInfos, err := digestTemplateData(globalTemplateInfos, settings, recipientEntries)
+			if err != nil {
+				counters.IncreaseCounter(false)
+				logger.Error.Printf("notification digest for %s: %v [%s:%s]", recipient, err, instanceID, settings.StudyKey)
+				continue
+			}
+			user := &umAPI.User{
+				Account: &umAPI.User_Account{
+					AccountId: recipient,
+					Type:      types.ACCOUNT_TYPE_EMAIL,
+				},
+			}
+			if err := queueOutgoingMessage(
+				user,
+				apiClients,
+				messageDBService,
+				instanceID,
+				template,
+				partials,
+				contentInfos,
+				false,
+				types.PRIORITY_STUDY_CRITICAL,
+				nil,
+				&counters,
+			); errors.Is(err, errNoChannel) {
+				counters.IncreaseSkipped()
+				continue
+			} else if err != nil {
+				counters.IncreaseCounter(false)
+				logger.Error.Printf("unexpected error: %v", err)
+				continue
+			}
+			counters.IncreaseCounter(true)
+			sentEntries = append(sentEntries, recipientEntries...)
+		}
+		if len(sentEntries) == 0 {
+			// nothing was queued, the digest is tried again in the next run instead of after the next interval
+			continue
+		}
+
+		if _, err := messageDBService.DeleteNotificationDigestEntries(instanceID, digestEntryIDs(sentEntries)); err != nil {
+			logger.Error.Printf("unexpected error when removing digest entries: %v", err)
+		}
+		if err := messageDBService.UpdateLastDigestAt(instanceID, settings.StudyKey, now.Unix()); err != nil {
+			logger.Error.Printf("unexpected error when updating the digest settings: %v", err)
+		}
+	}
+
+	counters.Stop()
+	logger.Info.Printf("Generated %d (%d failed) '%s' messages in %d s for %s.", counters.Total, counters.Failed, types.EMAIL_TYPE_NOTIFICATION_DIGEST, counters.Duration, messageLabel)
+}
+
+// findDigestTemplate returns the digest template of the study, or the one of the instance
+func findDigestTemplate(messageDBService *messagedb.MessageDBService, instanceID string, studyKey string) (types.EmailTemplate, error) {
+	template, err := messageDBService.FindEmailTemplateByType(instanceID, types.EMAIL_TYPE_NOTIFICATION_DIGEST, studyKey)
+	if err != nil && studyKey != "" {
+		return messageDBService.FindEmailTemplateByType(instanceID, types.EMAIL_TYPE_NOTIFICATION_DIGEST, "")
+	}
+	return template, err
+}
+
+// groupDigestEntries returns the recipients in the order of their first entry, and the entries of each recipient
+func groupDigestEntries(entries []types.NotificationDigestEntry) ([]string, map[string][]types.NotificationDigestEntry) {
+	recipients := []string{}
+	byRecipient := map[string][]types.NotificationDigestEntry{}
+	for _, e := range entries {
+		if _, ok := byRecipient[e.Recipient]; !ok {
+			recipients = append(recipients, e.Recipient)
+		}
+		byRecipient[e.Recipient] = append(byRecipient[e.Recipient], e)
+	}
+	return recipients, byRecipient
+}
+
+// digestTemplateData provides the entries as list for the digest template, each with its type, participantID,
+// createdAt and payload
+func digestTemplateData(
+	globalTemplateInfos map[string]string,
+	settings types.NotificationDigestSettings,
+	entries []types.NotificationDigestEntry,
+) (templates.TemplateData, error) {
+	items := make([]templates.TemplateData, len(entries))
+	for i, e := range entries {
+		payload := templates.TemplateData{}
+		if err := payload.AddPayload(e.Payload); err != nil {
+			return nil, err
+		}
+		items[i] = templates.TemplateData{
+			"type":          e.MessageType,
+			"participantID": e.ParticipantID,
+			"createdAt":     e.CreatedAt,
+			"payload":       payload,
+		}
+	}
+
+	contentInfos := templates.NewTemplateData(globalTemplateInfos)
+	contentInfos["studyKey"] = settings.StudyKey
+	contentInfos["digestMode"] = settings.Mode
+	contentInfos["entryCount"] = len(entries)
+	contentInfos["entries"] = items
+	return contentInfos, nil
+}
+
+func digestEntryIDs(entries []types.NotificationDigestEntry) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	return ids
+}
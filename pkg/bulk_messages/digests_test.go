@@ -0,0 +1,61 @@
+package bulk_messages
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestGroupDigestEntries(t *testing.T) {
+	entries := []types.NotificationDigestEntry{
+		{Recipient: "b@test.com", MessageType: "t1"},
+		{Recipient: "a@test.com", MessageType: "t2"},
+		{Recipient: "b@test.com", MessageType: "t3"},
+	}
+	recipients, byRecipient := groupDigestEntries(entries)
+	if len(recipients) != 2 || recipients[0] != "b@test.com" || recipients[1] != "a@test.com" {
+		t.Errorf("unexpected recipients: %v", recipients)
+	}
+	if len(byRecipient["b@test.com"]) != 2 || byRecipient["b@test.com"][1].MessageType != "t3" {
+		t.Errorf("unexpected entries: %v", byRecipient["b@test.com"])
+	}
+}
+
+func TestDigestTemplateData(t *testing.T) {
+	settings := types.NotificationDigestSettings{StudyKey: "study1", Mode: types.DIGEST_MODE_DAILY}
+
+	t.Run("with entries", func(t *testing.T) {
+		data, err := digestTemplateData(map[string]string{"webURL": "https://test.com"}, settings, []types.NotificationDigestEntry{
+			{MessageType: "flagged", ParticipantID: "p1", CreatedAt: 1700000000, Payload: map[string]string{"reason": "fever"}},
+			{MessageType: "flagged", ParticipantID: "p2", CreatedAt: 1700000100, Payload: map[string]string{"json:scores": "[1,2]"}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if data["webURL"] != "https://test.com" || data["studyKey"] != "study1" || data["entryCount"] != 2 {
+			t.Errorf("unexpected data: %v", data)
+		}
+		entries, ok := data["entries"].([]templates.TemplateData)
+		if !ok || len(entries) != 2 {
+			t.Errorf("unexpected entries: %v", data["entries"])
+			return
+		}
+		if entries[0]["participantID"] != "p1" || entries[0]["payload"].(templates.TemplateData)["reason"] != "fever" {
+			t.Errorf("unexpected entry: %v", entries[0])
+		}
+		if _, ok := entries[1]["payload"].(templates.TemplateData)["scores"].([]interface{}); !ok {
+			t.Errorf("json payload not decoded: %v", entries[1])
+		}
+	})
+
+	t.Run("with invalid json payload", func(t *testing.T) {
+		_, err := digestTemplateData(nil, settings, []types.NotificationDigestEntry{
+			{MessageType: "flagged", Payload: map[string]string{"json:scores": "[1,"}},
+		})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package bulk_messages
+
+import (
+	"errors"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// errCapped is returned for emails dropped by the frequency cap
+var errCapped = errors.New("email dropped by the frequency cap")
+
+// frequencyCap applies the frequency cap of the instance to the emails of a bulk run
+type frequencyCap struct {
+	settings         types.FrequencyCap
+	messageDBService *messagedb.MessageDBService
+	instanceID       string
+	recentEmailTimes func(address string, since int64) ([]int64, error)
+}
+
+// loadFrequencyCap returns nil if the instance has no frequency cap
+func loadFrequencyCap(messageDBService *messagedb.MessageDBService, instanceID string) *frequencyCap {
+	settings, err := messageDBService.FindFrequencyCap(instanceID)
+	if err != nil || settings.MaxMessages <= 0 {
+		return nil
+	}
+	return &frequencyCap{
+		settings:         settings,
+		messageDBService: messageDBService,
+		instanceID:       instanceID,
+		recentEmailTimes: func(address string, since int64) ([]int64, error) {
+			return messageDBService.FindRecentEmailTimes(instanceID, address, since)
+		},
+	}
+}
+
+// check returns the addresses the cap is reached for, and the time the email could be sent to all of them. Nothing
+// is recorded, e.g. for previews.
+func (c *frequencyCap) check(to []string, now int64) (cappedTo []string, sendAfter int64) {
+	cappedTo = []string{}
+	if c == nil {
+		return cappedTo, 0
+	}
+	for _, address := range to {
+		times, err := c.recentEmailTimes(address, now-c.settings.Period)
+		if err != nil {
+			logger.Error.Printf("recent emails to %s could not be loaded, frequency cap not applied: %v", address, err)
+			continue
+		}
+		capped, after := c.settings.Check(times, now)
+		if !capped {
+			continue
+		}
+		cappedTo = append(cappedTo, address)
+		if after > sendAfter {
+			sendAfter = after
+		}
+	}
+	return cappedTo, sendAfter
+}
+
+// drops returns true if capped emails are dropped instead of deferred
+func (c *frequencyCap) drops() bool {
+	return c != nil && c.settings.Policy == types.FREQUENCY_CAP_POLICY_DROP
+}
+
+// apply checks the recent emails to each address the email is sent to. If the cap is reached for one of them, the
+// email is deferred (sendAfter is set) or dropped (errCapped is returned) depending on the policy, and recorded in the
+// capped messages. Emails are not capped without frequency cap, or if the recent emails cannot be loaded.
+func (c *frequencyCap) apply(messageType string, to []string, counters *types.MessageCounter) (sendAfter int64, err error) {
+	if c == nil {
+		return 0, nil
+	}
+	now := time.Now().Unix()
+	cappedTo, sendAfter := c.check(to, now)
+	if len(cappedTo) == 0 {
+		return 0, nil
+	}
+
+	counters.IncreaseCapped()
+	for _, address := range cappedTo {
+		record := types.CappedMessage{
+			MessageType: messageType,
+			To:          address,
+			Policy:      c.settings.Policy,
+			CappedAt:    now,
+		}
+		if !c.drops() {
+			record.SendAfter = sendAfter
+		}
+		if _, err := c.messageDBService.AddCappedMessage(c.instanceID, record); err != nil {
+			logger.Error.Printf("capped message could not be recorded: %v", err)
+		}
+	}
+	if c.drops() {
+		return 0, errCapped
+	}
+	return sendAfter, nil
+}
@@ -0,0 +1,123 @@
+package bulk_messages
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	studyAPI "github.com/influenzanet/study-service/pkg/api"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+)
+
+// pendingParticipantMessage is a message of the study service for one profile of the user
+type pendingParticipantMessage struct {
+	profile  *umAPI.Profile
+	studyKey string
+	message  *studyAPI.StudyMessage
+}
+
+// fetchPendingParticipantMessages returns the messages of all profiles and studies of the user
+func fetchPendingParticipantMessages(apiClients *types.APIClients, instanceID string, user *umAPI.User) []pendingParticipantMessage {
+	pending := []pendingParticipantMessage{}
+	for _, profile := range user.Profiles {
+		studiesForUser, err := apiClients.StudyService.GetStudiesForUser(context.Background(), &studyAPI.GetStudiesForUserReq{
+			Token: &api_types.TokenInfos{
+				Id:         user.Id,
+				InstanceId: instanceID,
+				ProfilId:   profile.Id,
+			},
+		})
+		if err != nil {
+			logger.Debug.Printf("%s - %s: %v", instanceID, profile.Id, err)
+			continue
+		}
+		for _, study := range studiesForUser.GetStudies() {
+			resp, err := apiClients.StudyService.GetParticipantMessages(context.Background(), &studyAPI.GetParticipantMessagesReq{
+				InstanceId: instanceID,
+				StudyKey:   study.Key,
+				ProfileId:  profile.Id,
+			})
+			if err != nil {
+				// log needed only in debug mode, to prevent too much errors when profile is not a participant
+				logger.Debug.Printf("%s - %s - %s: %v", instanceID, study.Key, profile.Id, err)
+				continue
+			}
+			for _, m := range resp.Messages {
+				pending = append(pending, pendingParticipantMessage{profile: profile, studyKey: study.Key, message: m})
+			}
+		}
+	}
+	return pending
+}
+
+// deleteParticipantMessages removes the generated messages from the study service, per study and profile
+func deleteParticipantMessages(apiClients *types.APIClients, instanceID string, sent []pendingParticipantMessage) {
+	keys := []string{}
+	groups := map[string][]pendingParticipantMessage{}
+	for _, p := range sent {
+		key := p.studyKey + "/" + p.profile.Id
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], p)
+	}
+
+	for _, key := range keys {
+		group := groups[key]
+		messageIDs := make([]string, len(group))
+		for i, p := range group {
+			messageIDs[i] = p.message.Id
+		}
+		_, err := apiClients.StudyService.DeleteMessagesFromParticipant(context.Background(), &studyAPI.DeleteMessagesFromParticipantReq{
+			InstanceId: instanceID,
+			StudyKey:   group[0].studyKey,
+			ProfileId:  group[0].profile.Id,
+			MessageIds: messageIDs,
+		})
+		if err != nil {
+			logger.Error.Printf("unexpected error: %v", err)
+		}
+	}
+}
+
+// participantBatchTemplateData provides the pending messages of a user as list for the batch template, each with its
+// id, type, studyKey, profileId, profileAlias and payload, and the profiles the messages are for
+func participantBatchTemplateData(globalTemplateInfos map[string]string, pending []pendingParticipantMessage) (templates.TemplateData, error) {
+	messages := make([]templates.TemplateData, len(pending))
+	profiles := []templates.TemplateData{}
+	profileIndex := map[string]int{}
+	for i, p := range pending {
+		payload := templates.TemplateData{}
+		if err := payload.AddPayload(p.message.Payload); err != nil {
+			return nil, err
+		}
+		messages[i] = templates.TemplateData{
+			"id":           p.message.Id,
+			"type":         p.message.Type,
+			"studyKey":     p.studyKey,
+			"profileId":    p.profile.Id,
+			"profileAlias": p.profile.Alias,
+			"payload":      payload,
+		}
+
+		j, ok := profileIndex[p.profile.Id]
+		if !ok {
+			j = len(profiles)
+			profileIndex[p.profile.Id] = j
+			profiles = append(profiles, templates.TemplateData{
+				"id":           p.profile.Id,
+				"alias":        p.profile.Alias,
+				"messageCount": 0,
+			})
+		}
+		profiles[j]["messageCount"] = profiles[j]["messageCount"].(int) + 1
+	}
+
+	contentInfos := templates.NewTemplateData(globalTemplateInfos)
+	contentInfos["messageCount"] = len(pending)
+	contentInfos["messages"] = messages
+	contentInfos["profiles"] = profiles
+	return contentInfos, nil
+}
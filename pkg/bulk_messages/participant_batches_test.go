@@ -0,0 +1,47 @@
+package bulk_messages
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	studyAPI "github.com/influenzanet/study-service/pkg/api"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+)
+
+func TestParticipantBatchTemplateData(t *testing.T) {
+	mainProfile := &umAPI.Profile{Id: "p1", Alias: "Anna"}
+	childProfile := &umAPI.Profile{Id: "p2", Alias: "Ben"}
+
+	t.Run("with messages of two profiles", func(t *testing.T) {
+		data, err := participantBatchTemplateData(map[string]string{"webURL": "https://test.com"}, []pendingParticipantMessage{
+			{profile: mainProfile, studyKey: "study1", message: &studyAPI.StudyMessage{Id: "m1", Type: "reminder"}},
+			{profile: childProfile, studyKey: "study1", message: &studyAPI.StudyMessage{Id: "m2", Type: "reminder"}},
+			{profile: mainProfile, studyKey: "study2", message: &studyAPI.StudyMessage{Id: "m3", Type: "followup", Payload: map[string]string{"survey": "intake"}}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if data["webURL"] != "https://test.com" || data["messageCount"] != 3 {
+			t.Errorf("unexpected data: %v", data)
+		}
+		messages := data["messages"].([]templates.TemplateData)
+		if len(messages) != 3 || messages[2]["studyKey"] != "study2" || messages[2]["profileAlias"] != "Anna" ||
+			messages[2]["payload"].(templates.TemplateData)["survey"] != "intake" {
+			t.Errorf("unexpected messages: %v", messages)
+		}
+		profiles := data["profiles"].([]templates.TemplateData)
+		if len(profiles) != 2 || profiles[0]["alias"] != "Anna" || profiles[0]["messageCount"] != 2 || profiles[1]["messageCount"] != 1 {
+			t.Errorf("unexpected profiles: %v", profiles)
+		}
+	})
+
+	t.Run("with invalid json payload", func(t *testing.T) {
+		_, err := participantBatchTemplateData(nil, []pendingParticipantMessage{
+			{profile: mainProfile, studyKey: "study1", message: &studyAPI.StudyMessage{Id: "m1", Payload: map[string]string{"json:data": "{"}}},
+		})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package bulk_messages
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+)
+
+const (
+	defaultPreviewSampleCount = 2
+
+	// placeholders used instead of real tokens, since a preview must not create any
+	previewLoginToken       = "PREVIEW-LOGIN-TOKEN"
+	previewUnsubscribeToken = "PREVIEW-UNSUBSCRIBE-TOKEN"
+)
+
+// PreviewAudience runs the same recipient filters, channel routing and frequency cap as GenerateForAllUsers /
+// GenerateForStudyParticipants without generating tokens or writing to the outgoing queues or the capped messages.
+func PreviewAudience(
+	apiClients *types.APIClients,
+	messageDBService *messagedb.MessageDBService,
+	instanceID string,
+	messageType string,
+	messageTemplate types.EmailTemplate,
+	partials []types.TemplatePartial,
+	condition *api.ExpressionArg,
+	ignoreWeekday bool,
+	sampleCount int,
+) (*api.MessageAudiencePreview, error) {
+	findPushSubscriptions := func(userID string) ([]types.PushSubscription, error) {
+		return messageDBService.FindPushSubscriptions(instanceID, userID)
+	}
+	return previewAudience(
+		apiClients,
+		instanceID,
+		messageType,
+		messageTemplate,
+		partials,
+		condition,
+		ignoreWeekday,
+		sampleCount,
+		channelPreferenceLookup(messageDBService, instanceID),
+		findPushSubscriptions,
+		loadFrequencyCap(messageDBService, instanceID),
+	)
+}
+
+func previewAudience(
+	apiClients *types.APIClients,
+	instanceID string,
+	messageType string,
+	messageTemplate types.EmailTemplate,
+	partials []types.TemplatePartial,
+	condition *api.ExpressionArg,
+	ignoreWeekday bool,
+	sampleCount int,
+	findPreferredChannel func(userID string) (string, error),
+	findPushSubscriptions func(userID string) ([]types.PushSubscription, error),
+	freqCap *frequencyCap,
+) (*api.MessageAudiencePreview, error) {
+	checkStudyState := false
+	switch messageType {
+	case "all-users":
+	case "study-participants":
+		checkStudyState = true
+	default:
+		return nil, errors.New("message type unknown: " + messageType)
+	}
+	if sampleCount <= 0 {
+		sampleCount = defaultPreviewSampleCount
+	}
+
+	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
+
+	currentWeekday := time.Now().Weekday()
+	stream, err := getFilteredUserStream(apiClients, instanceID, messageTemplate.MessageType, int32(currentWeekday), ignoreWeekday)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &api.MessageAudiencePreview{
+		RecipientsByLanguage: map[string]int32{},
+		RecipientsByChannel:  map[string]int32{},
+		Samples:              []*api.MessagePreviewSample{},
+	}
+	now := time.Now().Unix()
+	for {
+		user, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !isEligibleRecipient(user, apiClients, instanceID, messageTemplate, condition, checkStudyState) {
+			continue
+		}
+
+		route, ok := chooseRoute(user, messageTemplate, findPreferredChannel, findPushSubscriptions)
+		if !ok {
+			continue
+		}
+
+		var translation types.LocalizedTemplate
+		switch route.channel {
+		case channels.CHANNEL_SMS:
+			translation, _ = templates.MatchSMSTranslation(instanceID, messageTemplate, user.Account.PreferredLanguage)
+		case channels.CHANNEL_PUSH:
+			translation, _ = templates.MatchPushTranslation(instanceID, messageTemplate, user.Account.PreferredLanguage)
+		default:
+			translation, _ = templates.SelectTemplateTranslation(instanceID, messageTemplate, user.Account.PreferredLanguage)
+			if cappedTo, _ := freqCap.check(emailRecipients(user, messageTemplate.MessageType, route.to), now); len(cappedTo) > 0 {
+				if freqCap.drops() {
+					preview.DroppedCount += 1
+					continue
+				}
+				preview.DeferredCount += 1
+			}
+		}
+		lang := translation.Lang
+		preview.RecipientCount += 1
+		preview.RecipientsByLanguage[lang] += 1
+		preview.RecipientsByChannel[route.channel] += 1
+
+		if route.channel != channels.CHANNEL_EMAIL || len(preview.Samples) >= sampleCount || hasSampleForLanguage(preview.Samples, lang) {
+			continue
+		}
+		contentInfos := templates.NewTemplateData(globalTemplateInfos)
+		sample, err := renderPreviewSample(instanceID, user, messageTemplate, partials, contentInfos, checkStudyState)
+		if err != nil {
+			logger.Error.Printf("PreviewAudience: %v", err)
+			continue
+		}
+		preview.Samples = append(preview.Samples, sample)
+	}
+	return preview, nil
+}
+
+func hasSampleForLanguage(samples []*api.MessagePreviewSample, lang string) bool {
+	for _, s := range samples {
+		if s.Language == lang {
+			return true
+		}
+	}
+	return false
+}
+
+func renderPreviewSample(
+	instanceID string,
+	user *umAPI.User,
+	messageTemplate types.EmailTemplate,
+	partials []types.TemplatePartial,
+	contentInfos templates.TemplateData,
+	includeLoginToken bool,
+) (*api.MessagePreviewSample, error) {
+	if messageTemplate.MessageType == constants.EMAIL_TYPE_NEWSLETTER {
+		contentInfos["unsubscribeToken"] = previewUnsubscribeToken
+	}
+	if includeLoginToken || messageTemplate.MessageType == constants.EMAIL_TYPE_WEEKLY || messageTemplate.MessageType == constants.EMAIL_TYPE_STUDY_REMINDER {
+		contentInfos["loginToken"] = previewLoginToken
+		contentInfos["studyKey"] = messageTemplate.StudyKey
+	}
+
+	lang := user.Account.PreferredLanguage
+	contentInfos["language"] = lang
+	translation, _ := templates.SelectTemplateTranslation(instanceID, messageTemplate, lang)
+	email, _, err := generateEmailContent(instanceID, messageTemplate, partials, lang, contentInfos)
+	if err != nil {
+		return nil, err
+	}
+	return &api.MessagePreviewSample{
+		Language: translation.Lang,
+		Subject:  email.Subject,
+		Content:  email.Content,
+	}, nil
+}
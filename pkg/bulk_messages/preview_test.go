@@ -0,0 +1,165 @@
+package bulk_messages
+
+import (
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	userMock "github.com/influenzanet/messaging-service/test/mocks/user-management-service"
+	"github.com/influenzanet/user-management-service/pkg/api"
+)
+
+func TestPreviewAudience(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockUserClient := userMock.NewMockUserManagementApiClient(mockCtrl)
+	mockStream := userMock.NewMockUserManagementApi_StreamUsersClient(mockCtrl)
+
+	apiClients := &types.APIClients{
+		UserManagementService: mockUserClient,
+	}
+
+	testTemplate := types.EmailTemplate{
+		MessageType:     "test-message",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", Subject: "EN", TemplateDef: base64.StdEncoding.EncodeToString([]byte(`<p>Hello {{.language}}</p>`))},
+			{Lang: "de", Subject: "DE", TemplateDef: base64.StdEncoding.EncodeToString([]byte(`<p>Hallo {{.language}}</p>`))},
+		},
+	}
+
+	noSubscriptions := func(userID string) ([]types.PushSubscription, error) {
+		return []types.PushSubscription{}, nil
+	}
+
+	t.Run("with unknown message type", func(t *testing.T) {
+		_, err := previewAudience(apiClients, "testinstance", "unknown", testTemplate, nil, nil, true, 2, nil, noSubscriptions, nil)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("with all users", func(t *testing.T) {
+		mockUserClient.EXPECT().StreamUsers(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(mockStream, nil)
+		gomock.InOrder(
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u1", Account: &api.User_Account{Type: "email", AccountId: "u1@test.com", PreferredLanguage: "en"}}, nil),
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u2", Account: &api.User_Account{Type: "email", AccountId: "u2@test.com", PreferredLanguage: "de"}}, nil),
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u3", Account: &api.User_Account{Type: "phone", AccountId: "123", PreferredLanguage: "de"}}, nil),
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u4", Account: &api.User_Account{Type: "email", AccountId: "u4@test.com", PreferredLanguage: "fr"}}, nil),
+			mockStream.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		preview, err := previewAudience(apiClients, "testinstance", "all-users", testTemplate, nil, nil, true, 5, nil, noSubscriptions, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if preview.RecipientCount != 3 {
+			t.Errorf("unexpected recipient count: %d", preview.RecipientCount)
+		}
+		if preview.RecipientsByChannel[channels.CHANNEL_EMAIL] != 3 {
+			t.Errorf("unexpected channel breakdown: %v", preview.RecipientsByChannel)
+		}
+		if preview.RecipientsByLanguage["en"] != 2 || preview.RecipientsByLanguage["de"] != 1 {
+			t.Errorf("unexpected language breakdown: %v", preview.RecipientsByLanguage)
+		}
+		if len(preview.Samples) != 2 {
+			t.Errorf("unexpected number of samples: %d", len(preview.Samples))
+			return
+		}
+		if preview.Samples[1].Subject != "DE" || !strings.Contains(preview.Samples[1].Content, "Hallo de") {
+			t.Errorf("unexpected sample: %v", preview.Samples[1])
+		}
+	})
+
+	t.Run("with routing rule", func(t *testing.T) {
+		routedTemplate := testTemplate
+		routedTemplate.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_SMS}
+		routedTemplate.Translations = []types.LocalizedTemplate{
+			{Lang: "en", Subject: "EN", TemplateDef: testTemplate.Translations[0].TemplateDef, SMSTemplate: "Hello", PushTemplate: "Hello"},
+			{Lang: "de", Subject: "DE", TemplateDef: testTemplate.Translations[1].TemplateDef},
+		}
+		withSubscription := func(userID string) ([]types.PushSubscription, error) {
+			if userID == "u1" {
+				return []types.PushSubscription{{UserID: userID, Endpoint: "https://push.test/1"}}, nil
+			}
+			return []types.PushSubscription{}, nil
+		}
+
+		mockUserClient.EXPECT().StreamUsers(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(mockStream, nil)
+		gomock.InOrder(
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u1", Account: &api.User_Account{Type: "email", AccountId: "u1@test.com", PreferredLanguage: "en"}}, nil),
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u2", Account: &api.User_Account{Type: "email", AccountId: "u2@test.com", PreferredLanguage: "de"}}, nil),
+			mockStream.EXPECT().Recv().Return(&api.User{Id: "u3", Account: &api.User_Account{Type: "phone", AccountId: "123", PreferredLanguage: "de"}}, nil),
+			mockStream.EXPECT().Recv().Return(nil, io.EOF),
+		)
+
+		preview, err := previewAudience(apiClients, "testinstance", "all-users", routedTemplate, nil, nil, true, 5, nil, withSubscription, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		// u2 has neither a push subscription nor a phone number
+		if preview.RecipientCount != 2 || preview.RecipientsByChannel[channels.CHANNEL_PUSH] != 1 || preview.RecipientsByChannel[channels.CHANNEL_SMS] != 1 {
+			t.Errorf("unexpected recipients: %d, %v", preview.RecipientCount, preview.RecipientsByChannel)
+		}
+		// the SMS of u3 is sent in the language with an SMS variant
+		if preview.RecipientsByLanguage["en"] != 2 {
+			t.Errorf("unexpected language breakdown: %v", preview.RecipientsByLanguage)
+		}
+		if len(preview.Samples) != 0 {
+			t.Errorf("unexpected samples: %v", preview.Samples)
+		}
+	})
+
+	t.Run("with frequency cap", func(t *testing.T) {
+		recentEmailTimes := func(address string, since int64) ([]int64, error) {
+			if address == "u1@test.com" {
+				return []int64{time.Now().Unix() - 10}, nil
+			}
+			return []int64{}, nil
+		}
+		for _, policy := range []string{types.FREQUENCY_CAP_POLICY_DEFER, types.FREQUENCY_CAP_POLICY_DROP} {
+			freqCap := &frequencyCap{
+				settings:         types.FrequencyCap{MaxMessages: 1, Period: 3600, Policy: policy},
+				recentEmailTimes: recentEmailTimes,
+			}
+			mockUserClient.EXPECT().StreamUsers(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(mockStream, nil)
+			gomock.InOrder(
+				mockStream.EXPECT().Recv().Return(&api.User{Id: "u1", Account: &api.User_Account{Type: "email", AccountId: "u1@test.com", PreferredLanguage: "en"}}, nil),
+				mockStream.EXPECT().Recv().Return(&api.User{Id: "u2", Account: &api.User_Account{Type: "email", AccountId: "u2@test.com", PreferredLanguage: "de"}}, nil),
+				mockStream.EXPECT().Recv().Return(nil, io.EOF),
+			)
+
+			preview, err := previewAudience(apiClients, "testinstance", "all-users", testTemplate, nil, nil, true, 5, nil, noSubscriptions, freqCap)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			switch policy {
+			case types.FREQUENCY_CAP_POLICY_DEFER:
+				if preview.RecipientCount != 2 || preview.DeferredCount != 1 || preview.DroppedCount != 0 {
+					t.Errorf("unexpected preview with defer policy: %v", preview)
+				}
+			case types.FREQUENCY_CAP_POLICY_DROP:
+				if preview.RecipientCount != 1 || preview.DeferredCount != 0 || preview.DroppedCount != 1 {
+					t.Errorf("unexpected preview with drop policy: %v", preview)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package bulk_messages
+
+import (
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+)
+
+// queuePushNotifications adds a push notification for each of the subscriptions, users without subscriptions did not
+// opt in. The content infos must already contain the tokens. Language fallbacks are logged once per run, and counted if
+// countFallbacks is set (not for notifications in addition to an email, which is counted).
+func queuePushNotifications(
+	user *umAPI.User,
+	subs []types.PushSubscription,
+	messageDBService *messagedb.MessageDBService,
+	instanceID string,
+	messageTemplate types.EmailTemplate,
+	contentInfos templates.TemplateData,
+	counters *types.MessageCounter,
+	countFallbacks bool,
+) error {
+	if len(subs) == 0 || !messageTemplate.HasPushVariant() {
+		return nil
+	}
+
+	prefLang := user.Account.PreferredLanguage
+	translation, usedDefaultLanguage := templates.MatchPushTranslation(instanceID, messageTemplate, prefLang)
+	push, err := templates.ResolvePushTemplate(messageTemplate.MessageType+prefLang, translation, contentInfos)
+	if err != nil {
+		return err
+	}
+	if usedDefaultLanguage {
+		if countFallbacks {
+			counters.IncreaseLanguageFallbacks()
+		}
+		logLanguageFallback(counters, instanceID, messageTemplate, "push variant", prefLang)
+	}
+	for _, sub := range subs {
+		_, err := messageDBService.AddToOutgoingPush(instanceID, types.OutgoingPush{
+			MessageType:     messageTemplate.MessageType,
+			Subscription:    sub,
+			Title:           push.Title,
+			Body:            push.Body,
+			AddedAt:         time.Now().Unix(),
+			TemplateVersion: messageTemplate.Version,
+			Channel:         channels.CHANNEL_PUSH,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package bulk_messages
+
+import (
+	"errors"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// errNoChannel is returned if none of the channels of the routing rule is available for the user
+var errNoChannel = errors.New("no channel available for the user")
+
+// deliveryRoute is the channel a message is sent over, with the user's address on it
+type deliveryRoute struct {
+	channel       string
+	to            string                   // email address or phone number
+	subscriptions []types.PushSubscription // push only
+}
+
+// chooseRoute evaluates the routing rule of the template for the user: the first of its channels the user can be
+// reached on, starting with the channel the user prefers if the rule contains it. Without routing rule, the channel of
+// the user's account is used.
+func chooseRoute(
+	user *umAPI.User,
+	messageTemplate types.EmailTemplate,
+	findPreferredChannel func(userID string) (string, error),
+	findPushSubscriptions func(userID string) ([]types.PushSubscription, error),
+) (deliveryRoute, bool) {
+	if len(messageTemplate.Channels) == 0 {
+		switch user.Account.Type {
+		case types.ACCOUNT_TYPE_EMAIL:
+			return deliveryRoute{channel: channels.CHANNEL_EMAIL, to: user.Account.AccountId}, true
+		case types.ACCOUNT_TYPE_PHONE:
+			if messageTemplate.HasSMSVariant() {
+				return deliveryRoute{channel: channels.CHANNEL_SMS, to: user.Account.AccountId}, true
+			}
+		}
+		return deliveryRoute{}, false
+	}
+
+	for _, ch := range routeOrder(user, messageTemplate.Channels, findPreferredChannel) {
+		to, ok := channelAddress(user, messageTemplate, ch)
+		if !ok {
+			continue
+		}
+		if ch != channels.CHANNEL_PUSH {
+			return deliveryRoute{channel: ch, to: to}, true
+		}
+		subs, err := findPushSubscriptions(user.Id)
+		if err != nil {
+			logger.Error.Printf("push subscriptions of user %s could not be loaded: %v", user.Id, err)
+			continue
+		}
+		if len(subs) > 0 {
+			return deliveryRoute{channel: ch, subscriptions: subs}, true
+		}
+	}
+	return deliveryRoute{}, false
+}
+
+// routeOrder returns the channels of the routing rule with the user's preferred channel first. The preference is only
+// loaded if the rule leaves a choice, if it cannot be loaded the order of the rule is used.
+func routeOrder(user *umAPI.User, ruleChannels []string, findPreferredChannel func(userID string) (string, error)) []string {
+	if len(ruleChannels) < 2 || findPreferredChannel == nil || user.Id == "" {
+		return ruleChannels
+	}
+	preferred, err := findPreferredChannel(user.Id)
+	if err != nil {
+		logger.Error.Printf("channel preference of user %s could not be loaded: %v", user.Id, err)
+		return ruleChannels
+	}
+	order := []string{}
+	for _, ch := range ruleChannels {
+		if ch == preferred {
+			order = append(order, ch)
+		}
+	}
+	if len(order) == 0 {
+		return ruleChannels
+	}
+	for _, ch := range ruleChannels {
+		if ch != preferred {
+			order = append(order, ch)
+		}
+	}
+	return order
+}
+
+// channelPreferenceLookup loads the preferred channel of users of the instance, empty if the user has none
+func channelPreferenceLookup(messageDBService *messagedb.MessageDBService, instanceID string) func(userID string) (string, error) {
+	return func(userID string) (string, error) {
+		pref, err := messageDBService.FindChannelPreference(instanceID, userID)
+		if err == mongo.ErrNoDocuments {
+			return "", nil
+		}
+		return pref.Channel, err
+	}
+}
+
+// canReceive checks without database access if the user may be reachable: for templates without routing rule email
+// accounts, and phone accounts if the template has an SMS variant
+func canReceive(user *umAPI.User, messageTemplate types.EmailTemplate) bool {
+	if len(messageTemplate.Channels) == 0 {
+		_, ok := chooseRoute(user, messageTemplate, nil, nil)
+		return ok
+	}
+	for _, ch := range messageTemplate.Channels {
+		if _, ok := channelAddress(user, messageTemplate, ch); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// channelAddress returns the user's address on the channel, if the template can be sent over it. Push notifications
+// additionally need a subscription of the user.
+func channelAddress(user *umAPI.User, messageTemplate types.EmailTemplate, channel string) (string, bool) {
+	switch channel {
+	case channels.CHANNEL_EMAIL:
+		if user.Account.Type == types.ACCOUNT_TYPE_EMAIL {
+			return user.Account.AccountId, true
+		}
+		return confirmedContact(user, types.ACCOUNT_TYPE_EMAIL)
+	case channels.CHANNEL_SMS:
+		if !messageTemplate.HasSMSVariant() {
+			return "", false
+		}
+		if user.Account.Type == types.ACCOUNT_TYPE_PHONE {
+			return user.Account.AccountId, true
+		}
+		return confirmedContact(user, types.ACCOUNT_TYPE_PHONE)
+	case channels.CHANNEL_PUSH:
+		return "", messageTemplate.HasPushVariant() && user.Id != ""
+	}
+	return "", false
+}
+
+// confirmedContact returns the first confirmed email address or phone number of the user's contact infos
+func confirmedContact(user *umAPI.User, contactType string) (string, bool) {
+	for _, c := range user.ContactInfos {
+		if c.Type != contactType || c.ConfirmedAt <= 0 {
+			continue
+		}
+		switch contactType {
+		case types.ACCOUNT_TYPE_EMAIL:
+			return c.GetEmail(), c.GetEmail() != ""
+		case types.ACCOUNT_TYPE_PHONE:
+			return c.GetPhone(), c.GetPhone() != ""
+		}
+	}
+	return "", false
+}
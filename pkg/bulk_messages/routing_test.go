@@ -0,0 +1,148 @@
+package bulk_messages
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+)
+
+func TestChooseRoute(t *testing.T) {
+	template := types.EmailTemplate{
+		MessageType: "study-reminder",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: "PHA+dGVzdDwvcD4=", SMSTemplate: "test", PushTemplate: "test\ntest"},
+		},
+	}
+	emailUser := &umAPI.User{
+		Id:      "user1",
+		Account: &umAPI.User_Account{AccountId: "test@test.com", Type: types.ACCOUNT_TYPE_EMAIL},
+		ContactInfos: []*umAPI.ContactInfo{
+			{Type: types.ACCOUNT_TYPE_PHONE, ConfirmedAt: 0, Address: &umAPI.ContactInfo_Phone{Phone: "+4100000"}},
+			{Type: types.ACCOUNT_TYPE_PHONE, ConfirmedAt: 1, Address: &umAPI.ContactInfo_Phone{Phone: "+4111111"}},
+		},
+	}
+	phoneUser := &umAPI.User{
+		Id:      "user2",
+		Account: &umAPI.User_Account{AccountId: "+4122222", Type: types.ACCOUNT_TYPE_PHONE},
+	}
+	noSubscriptions := func(userID string) ([]types.PushSubscription, error) {
+		return []types.PushSubscription{}, nil
+	}
+	withSubscription := func(userID string) ([]types.PushSubscription, error) {
+		return []types.PushSubscription{{UserID: userID, Endpoint: "https://push.test/1"}}, nil
+	}
+
+	t.Run("without routing rule", func(t *testing.T) {
+		route, ok := chooseRoute(emailUser, template, nil, withSubscription)
+		if !ok || route.channel != channels.CHANNEL_EMAIL || route.to != "test@test.com" {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+		route, ok = chooseRoute(phoneUser, template, nil, withSubscription)
+		if !ok || route.channel != channels.CHANNEL_SMS || route.to != "+4122222" {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("push first with subscription", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL}
+		route, ok := chooseRoute(emailUser, tmp, nil, withSubscription)
+		if !ok || route.channel != channels.CHANNEL_PUSH || len(route.subscriptions) != 1 {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("falls back if there is no push subscription", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL}
+		route, ok := chooseRoute(emailUser, tmp, nil, noSubscriptions)
+		if !ok || route.channel != channels.CHANNEL_EMAIL || route.to != "test@test.com" {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("falls back if the subscriptions cannot be loaded", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL}
+		route, ok := chooseRoute(emailUser, tmp, nil, func(userID string) ([]types.PushSubscription, error) {
+			return nil, errors.New("db error")
+		})
+		if !ok || route.channel != channels.CHANNEL_EMAIL {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("sms to confirmed phone number of email account", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_SMS, channels.CHANNEL_EMAIL}
+		route, ok := chooseRoute(emailUser, tmp, nil, noSubscriptions)
+		if !ok || route.channel != channels.CHANNEL_SMS || route.to != "+4111111" {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("preferred channel first", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL, channels.CHANNEL_SMS}
+		prefersSMS := func(userID string) (string, error) {
+			return channels.CHANNEL_SMS, nil
+		}
+		route, ok := chooseRoute(emailUser, tmp, prefersSMS, withSubscription)
+		if !ok || route.channel != channels.CHANNEL_SMS || route.to != "+4111111" {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("falls back to the rule if the preferred channel is not available", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_EMAIL, channels.CHANNEL_PUSH}
+		prefersPush := func(userID string) (string, error) {
+			return channels.CHANNEL_PUSH, nil
+		}
+		route, ok := chooseRoute(emailUser, tmp, prefersPush, noSubscriptions)
+		if !ok || route.channel != channels.CHANNEL_EMAIL {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("preferred channel not in the rule", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL}
+		prefersSMS := func(userID string) (string, error) {
+			return channels.CHANNEL_SMS, nil
+		}
+		route, ok := chooseRoute(emailUser, tmp, prefersSMS, withSubscription)
+		if !ok || route.channel != channels.CHANNEL_PUSH {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("uses the rule if the preference cannot be loaded", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL}
+		route, ok := chooseRoute(emailUser, tmp, func(userID string) (string, error) {
+			return "", errors.New("db error")
+		}, withSubscription)
+		if !ok || route.channel != channels.CHANNEL_PUSH {
+			t.Errorf("unexpected route: %v %v", route, ok)
+		}
+	})
+
+	t.Run("no channel available", func(t *testing.T) {
+		tmp := template
+		tmp.Channels = []string{channels.CHANNEL_PUSH, channels.CHANNEL_EMAIL}
+		if route, ok := chooseRoute(phoneUser, tmp, nil, noSubscriptions); ok {
+			t.Errorf("unexpected route: %v", route)
+		}
+		if !canReceive(phoneUser, tmp) {
+			t.Error("user could subscribe to push notifications")
+		}
+		tmp.Channels = []string{channels.CHANNEL_EMAIL}
+		if canReceive(phoneUser, tmp) {
+			t.Error("user has no email address")
+		}
+	})
+}
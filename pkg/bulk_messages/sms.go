@@ -0,0 +1,60 @@
+package bulk_messages
+
+import (
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+)
+
+// prepareOutgoingSMS renders the SMS variant of the translation for the user's language, to is the phone number chosen
+// by the routing rule
+func prepareOutgoingSMS(
+	user *umAPI.User,
+	to string,
+	apiClients *types.APIClients,
+	instanceID string,
+	messageTemplate types.EmailTemplate,
+	contentInfos templates.TemplateData,
+	includeLoginToken bool,
+	counters *types.MessageCounter,
+) (*types.OutgoingSMS, error) {
+	outgoingSMS := types.OutgoingSMS{
+		MessageType:     messageTemplate.MessageType,
+		To:              to,
+		AddedAt:         time.Now().Unix(),
+		TemplateVersion: messageTemplate.Version,
+		Channel:         channels.CHANNEL_SMS,
+	}
+
+	if err := addUserTokens(user, apiClients, instanceID, messageTemplate, contentInfos, includeLoginToken); err != nil {
+		return nil, err
+	}
+
+	prefLang := user.Account.PreferredLanguage
+	contentInfos["language"] = prefLang
+	text, usedDefaultLanguage, err := generateSMSText(instanceID, messageTemplate, prefLang, contentInfos)
+	if err != nil {
+		return nil, err
+	}
+	if usedDefaultLanguage {
+		counters.IncreaseLanguageFallbacks()
+		logLanguageFallback(counters, instanceID, messageTemplate, "SMS variant", prefLang)
+	}
+	outgoingSMS.Text = text
+	return &outgoingSMS, nil
+}
+
+// generateSMSText renders the SMS variant of the translation for prefLang, usedDefaultLanguage is set if it is not available
+func generateSMSText(
+	instanceID string,
+	temp types.EmailTemplate,
+	prefLang string,
+	contentInfos templates.TemplateData,
+) (text string, usedDefaultLanguage bool, err error) {
+	translation, usedDefaultLanguage := templates.MatchSMSTranslation(instanceID, temp, prefLang)
+	text, err = templates.ResolveSMSTemplate(temp.MessageType+prefLang, translation, contentInfos)
+	return text, usedDefaultLanguage, err
+}
@@ -0,0 +1,51 @@
+package bulk_messages
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/dbs/messagedb"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	studyAPI "github.com/influenzanet/study-service/pkg/api"
+)
+
+// queueWebhookNotification adds the researcher notification to the outgoing webhooks of the target, with the fields
+// the study service provided as payload
+func queueWebhookNotification(
+	messageDBService *messagedb.MessageDBService,
+	instanceID string,
+	targetName string,
+	m *studyAPI.StudyMessage,
+) error {
+	target, err := messageDBService.FindWebhookTarget(instanceID, targetName)
+	if err != nil {
+		return errors.New("webhook target not found")
+	}
+	if target.StudyKey != "" && target.StudyKey != m.StudyKey {
+		return errors.New("webhook target is restricted to study " + target.StudyKey)
+	}
+
+	now := time.Now().Unix()
+	payload, err := json.Marshal(types.WebhookPayload{
+		ID:            m.Id,
+		Type:          m.Type,
+		InstanceID:    instanceID,
+		StudyKey:      m.StudyKey,
+		ParticipantID: m.ParticipantId,
+		Payload:       m.Payload,
+		CreatedAt:     now,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = messageDBService.AddToOutgoingWebhooks(instanceID, types.OutgoingWebhook{
+		TargetName:     target.Name,
+		MessageType:    m.Type,
+		StudyKey:       m.StudyKey,
+		NotificationID: m.Id,
+		Payload:        string(payload),
+		AddedAt:        now,
+	})
+	return err
+}
@@ -0,0 +1,207 @@
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	emailAPI "github.com/influenzanet/messaging-service/pkg/api/email_client_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+const (
+	CHANNEL_EMAIL   = "email"
+	CHANNEL_SMS     = "sms"
+	CHANNEL_PUSH    = "push"
+	CHANNEL_WEBHOOK = "webhook"
+)
+
+// Message is a rendered message for the addresses of one channel
+type Message struct {
+	InstanceID       string // email only, for the routes of the SMTP pools
+	MessageType      string
+	To               []string // addresses, phone numbers or URLs of webhooks
+	Subject          string   // subject of emails, title of push notifications
+	Content          string   // HTML content of emails, text of SMS and push notifications, JSON body of webhooks
+	TextContent      string   // plain-text part of emails
+	HeaderOverrides  *types.HeaderOverrides
+	PushSubscription *types.PushSubscription // push only, used instead of To
+	WebhookSecret    string                  // webhook only, key of the payload signature
+	HighPrio         bool
+	Priority         string // email only, selects the SMTP pool
+}
+
+// Sender delivers messages over one channel
+type Sender interface {
+	Channel() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMSProvider sends a text message to a phone number, e.g. sms_client.SMSClient
+type SMSProvider interface {
+	SendSMS(ctx context.Context, to string, text string) error
+}
+
+// PushProvider sends an encrypted payload to a web push subscription, e.g. push_client.PushClient
+type PushProvider interface {
+	SendPush(ctx context.Context, sub types.PushSubscription, payload []byte) error
+}
+
+// WebhookProvider posts a signed JSON body to a URL, e.g. webhook_client.WebhookClient
+type WebhookProvider interface {
+	Post(ctx context.Context, url string, secret string, body []byte) error
+}
+
+// EmailSender delivers messages through the email client service
+type EmailSender struct {
+	client emailAPI.EmailClientServiceApiClient
+}
+
+func NewEmailSender(client emailAPI.EmailClientServiceApiClient) *EmailSender {
+	return &EmailSender{client: client}
+}
+
+func (s *EmailSender) Channel() string {
+	return CHANNEL_EMAIL
+}
+
+func (s *EmailSender) Send(ctx context.Context, msg Message) error {
+	_, err := s.client.SendEmail(ctx, &emailAPI.SendEmailReq{
+		To:              msg.To,
+		HeaderOverrides: msg.HeaderOverrides.ToEmailClientAPI(),
+		Subject:         msg.Subject,
+		Content:         msg.Content,
+		TextContent:     msg.TextContent,
+		HighPrio:        msg.HighPrio,
+		Priority:        msg.Priority,
+		InstanceId:      msg.InstanceID,
+		MessageType:     msg.MessageType,
+	})
+	return err
+}
+
+// SMSSender delivers the content of messages as SMS, one per phone number
+type SMSSender struct {
+	provider SMSProvider
+}
+
+func NewSMSSender(provider SMSProvider) *SMSSender {
+	return &SMSSender{provider: provider}
+}
+
+func (s *SMSSender) Channel() string {
+	return CHANNEL_SMS
+}
+
+func (s *SMSSender) Send(ctx context.Context, msg Message) error {
+	for _, to := range msg.To {
+		if err := s.provider.SendSMS(ctx, to, msg.Content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PushSender delivers messages as web push notifications, the payload is the JSON the service worker of the
+// participant app shows the notification with
+type PushSender struct {
+	provider PushProvider
+}
+
+type pushPayload struct {
+	Title       string `json:"title"`
+	Body        string `json:"body"`
+	MessageType string `json:"messageType,omitempty"`
+}
+
+func NewPushSender(provider PushProvider) *PushSender {
+	return &PushSender{provider: provider}
+}
+
+func (s *PushSender) Channel() string {
+	return CHANNEL_PUSH
+}
+
+func (s *PushSender) Send(ctx context.Context, msg Message) error {
+	if msg.PushSubscription == nil {
+		return errors.New("message without push subscription")
+	}
+	payload, err := json.Marshal(pushPayload{
+		Title:       msg.Subject,
+		Body:        msg.Content,
+		MessageType: msg.MessageType,
+	})
+	if err != nil {
+		return err
+	}
+	return s.provider.SendPush(ctx, *msg.PushSubscription, payload)
+}
+
+// WebhookSender posts the content of messages to webhook targets
+type WebhookSender struct {
+	provider WebhookProvider
+}
+
+func NewWebhookSender(provider WebhookProvider) *WebhookSender {
+	return &WebhookSender{provider: provider}
+}
+
+func (s *WebhookSender) Channel() string {
+	return CHANNEL_WEBHOOK
+}
+
+func (s *WebhookSender) Send(ctx context.Context, msg Message) error {
+	for _, url := range msg.To {
+		if err := s.provider.Post(ctx, url, msg.WebhookSecret, []byte(msg.Content)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MessageFromOutgoingEmail converts a queued email of the instance for the email sender
+func MessageFromOutgoingEmail(instanceID string, email types.OutgoingEmail) Message {
+	return Message{
+		InstanceID:      instanceID,
+		MessageType:     email.MessageType,
+		To:              email.To,
+		Subject:         email.Subject,
+		Content:         email.Content,
+		TextContent:     email.TextContent,
+		HeaderOverrides: email.HeaderOverrides,
+		HighPrio:        types.IsHighPriority(email.GetPriority()),
+		Priority:        email.GetPriority(),
+	}
+}
+
+// MessageFromOutgoingSMS converts a queued SMS for the SMS sender
+func MessageFromOutgoingSMS(sms types.OutgoingSMS) Message {
+	return Message{
+		MessageType: sms.MessageType,
+		To:          []string{sms.To},
+		Content:     sms.Text,
+		HighPrio:    sms.HighPrio,
+	}
+}
+
+// MessageFromOutgoingPush converts a queued push notification for the push sender
+func MessageFromOutgoingPush(push types.OutgoingPush) Message {
+	sub := push.Subscription
+	return Message{
+		MessageType:      push.MessageType,
+		Subject:          push.Title,
+		Content:          push.Body,
+		PushSubscription: &sub,
+		HighPrio:         push.HighPrio,
+	}
+}
+
+// MessageFromOutgoingWebhook converts a queued notification for the webhook sender, with the URL and secret of its target
+func MessageFromOutgoingWebhook(webhook types.OutgoingWebhook, target types.WebhookTarget) Message {
+	return Message{
+		MessageType:   webhook.MessageType,
+		To:            []string{target.URL},
+		Content:       webhook.Payload,
+		WebhookSecret: target.Secret,
+	}
+}
@@ -0,0 +1,184 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	emailAPI "github.com/influenzanet/messaging-service/pkg/api/email_client_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	emailMock "github.com/influenzanet/messaging-service/test/mocks/email-client-service"
+)
+
+type testSMSProvider struct {
+	sent []string
+	err  error
+}
+
+func (p *testSMSProvider) SendSMS(ctx context.Context, to string, text string) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.sent = append(p.sent, to+": "+text)
+	return nil
+}
+
+func TestEmailSender(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEmailClient := emailMock.NewMockEmailClientServiceApiClient(mockCtrl)
+	sender := NewEmailSender(mockEmailClient)
+
+	t.Run("with outgoing email", func(t *testing.T) {
+		mockEmailClient.EXPECT().SendEmail(
+			gomock.Any(),
+			&emailAPI.SendEmailReq{
+				To:          []string{"test@example.org"},
+				Subject:     "Reminder",
+				Content:     "<p>Reminder</p>",
+				TextContent: "Reminder",
+				HighPrio:    true,
+				Priority:    types.PRIORITY_TRANSACTIONAL,
+				InstanceId:  "test-instance",
+				MessageType: "reminder",
+			},
+		).Return(&emailAPI.ServiceStatus{}, nil)
+
+		err := sender.Send(context.Background(), MessageFromOutgoingEmail("test-instance", types.OutgoingEmail{
+			MessageType: "reminder",
+			To:          []string{"test@example.org"},
+			Subject:     "Reminder",
+			Content:     "<p>Reminder</p>",
+			TextContent: "Reminder",
+			HighPrio:    true,
+		}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with priority", func(t *testing.T) {
+		mockEmailClient.EXPECT().SendEmail(
+			gomock.Any(),
+			&emailAPI.SendEmailReq{
+				To:          []string{"test@example.org"},
+				Subject:     "News",
+				Content:     "<p>News</p>",
+				Priority:    types.PRIORITY_NEWSLETTER,
+				InstanceId:  "test-instance",
+				MessageType: "newsletter",
+			},
+		).Return(&emailAPI.ServiceStatus{}, nil)
+
+		email := types.OutgoingEmail{
+			MessageType: "newsletter",
+			To:          []string{"test@example.org"},
+			Subject:     "News",
+			Content:     "<p>News</p>",
+		}
+		email.SetPriority(types.PRIORITY_NEWSLETTER)
+		if err := sender.Send(context.Background(), MessageFromOutgoingEmail("test-instance", email)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with email client error", func(t *testing.T) {
+		mockEmailClient.EXPECT().SendEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+		err := sender.Send(context.Background(), Message{To: []string{"test@example.org"}})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestSMSSender(t *testing.T) {
+	t.Run("with outgoing SMS", func(t *testing.T) {
+		provider := &testSMSProvider{}
+		err := NewSMSSender(provider).Send(context.Background(), MessageFromOutgoingSMS(types.OutgoingSMS{
+			MessageType: "reminder",
+			To:          "+4917012345678",
+			Text:        "Reminder",
+		}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(provider.sent) != 1 || provider.sent[0] != "+4917012345678: Reminder" {
+			t.Errorf("unexpected messages: %v", provider.sent)
+		}
+	})
+
+	t.Run("with provider error", func(t *testing.T) {
+		provider := &testSMSProvider{err: errors.New("invalid number")}
+		err := NewSMSSender(provider).Send(context.Background(), Message{To: []string{"123"}, Content: "Reminder"})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+type testPushProvider struct {
+	endpoint string
+	payload  string
+}
+
+func (p *testPushProvider) SendPush(ctx context.Context, sub types.PushSubscription, payload []byte) error {
+	p.endpoint = sub.Endpoint
+	p.payload = string(payload)
+	return nil
+}
+
+func TestPushSender(t *testing.T) {
+	t.Run("without subscription", func(t *testing.T) {
+		err := NewPushSender(&testPushProvider{}).Send(context.Background(), Message{Subject: "Reminder"})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("with outgoing push notification", func(t *testing.T) {
+		provider := &testPushProvider{}
+		err := NewPushSender(provider).Send(context.Background(), MessageFromOutgoingPush(types.OutgoingPush{
+			MessageType:  "reminder",
+			Subscription: types.PushSubscription{Endpoint: "https://push.example.org/1"},
+			Title:        "Reminder",
+			Body:         "Your survey is waiting",
+		}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if provider.endpoint != "https://push.example.org/1" {
+			t.Errorf("unexpected endpoint: %s", provider.endpoint)
+		}
+		if provider.payload != `{"title":"Reminder","body":"Your survey is waiting","messageType":"reminder"}` {
+			t.Errorf("unexpected payload: %s", provider.payload)
+		}
+	})
+}
+
+type testWebhookProvider struct {
+	posted []string
+}
+
+func (p *testWebhookProvider) Post(ctx context.Context, url string, secret string, body []byte) error {
+	p.posted = append(p.posted, url+" ("+secret+"): "+string(body))
+	return nil
+}
+
+func TestWebhookSender(t *testing.T) {
+	provider := &testWebhookProvider{}
+	err := NewWebhookSender(provider).Send(context.Background(), MessageFromOutgoingWebhook(
+		types.OutgoingWebhook{MessageType: "participant-flagged", Payload: `{"type":"participant-flagged"}`},
+		types.WebhookTarget{Name: "redcap", URL: "https://example.com/hook", Secret: "secret"},
+	))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(provider.posted) != 1 || provider.posted[0] != `https://example.com/hook (secret): {"type":"participant-flagged"}` {
+		t.Errorf("unexpected requests: %v", provider.posted)
+	}
+}
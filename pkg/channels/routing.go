@@ -0,0 +1,44 @@
+package channels
+
+import (
+	"errors"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// CheckRoutingRule returns an error if the channels of the template are unknown, repeated, or need a variant the
+// template does not have
+func CheckRoutingRule(t types.EmailTemplate) error {
+	seen := map[string]bool{}
+	for _, ch := range t.Channels {
+		if seen[ch] {
+			return errors.New("channel `" + ch + "` is listed twice")
+		}
+		seen[ch] = true
+
+		switch ch {
+		case CHANNEL_EMAIL:
+		case CHANNEL_SMS:
+			if !t.HasSMSVariant() {
+				return errors.New("channel `sms` needs an SMS variant of the template")
+			}
+		case CHANNEL_PUSH:
+			if !t.HasPushVariant() {
+				return errors.New("channel `push` needs a push variant of the template")
+			}
+		default:
+			return errors.New("unknown channel `" + ch + "`")
+		}
+	}
+	return nil
+}
+
+// CheckPreferredChannel returns an error if the channel cannot be preferred by a user, an empty channel removes the
+// preference
+func CheckPreferredChannel(ch string) error {
+	switch ch {
+	case "", CHANNEL_EMAIL, CHANNEL_SMS, CHANNEL_PUSH:
+		return nil
+	}
+	return errors.New("unknown channel `" + ch + "`")
+}
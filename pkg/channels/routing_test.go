@@ -0,0 +1,68 @@
+package channels
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestCheckRoutingRule(t *testing.T) {
+	withVariants := []types.LocalizedTemplate{
+		{Lang: "en", SMSTemplate: "Reminder", PushTemplate: "Reminder"},
+	}
+	withoutVariants := []types.LocalizedTemplate{{Lang: "en"}}
+
+	t.Run("without rule", func(t *testing.T) {
+		if err := CheckRoutingRule(types.EmailTemplate{Translations: withoutVariants}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("push with fallback to email", func(t *testing.T) {
+		err := CheckRoutingRule(types.EmailTemplate{Translations: withVariants, Channels: []string{"push", "email"}})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("push without variant", func(t *testing.T) {
+		err := CheckRoutingRule(types.EmailTemplate{Translations: withoutVariants, Channels: []string{"push", "email"}})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("sms without variant", func(t *testing.T) {
+		err := CheckRoutingRule(types.EmailTemplate{Translations: withoutVariants, Channels: []string{"sms"}})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		err := CheckRoutingRule(types.EmailTemplate{Translations: withVariants, Channels: []string{"fax"}})
+		if err == nil || err.Error() != "unknown channel `fax`" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("repeated channel", func(t *testing.T) {
+		err := CheckRoutingRule(types.EmailTemplate{Translations: withVariants, Channels: []string{"email", "email"}})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestCheckPreferredChannel(t *testing.T) {
+	for _, ch := range []string{"", "email", "sms", "push"} {
+		if err := CheckPreferredChannel(ch); err != nil {
+			t.Errorf("unexpected error for `%s`: %v", ch, err)
+		}
+	}
+	for _, ch := range []string{"webhook", "fax"} {
+		if err := CheckPreferredChannel(ch); err == nil {
+			t.Errorf("should return an error for `%s`", ch)
+		}
+	}
+}
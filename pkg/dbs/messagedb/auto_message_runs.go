@@ -0,0 +1,63 @@
+package messagedb
+
+import (
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *MessageDBService) AddAutoMessageRun(instanceID string, run types.AutoMessageRun) (types.AutoMessageRun, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefAutoMessageRuns(instanceID).InsertOne(ctx, run)
+	if err != nil {
+		return run, err
+	}
+	run.ID = res.InsertedID.(primitive.ObjectID)
+	return run, nil
+}
+
+// FindAutoMessageRuns returns the most recent runs first, optionally only for one auto message
+func (dbService *MessageDBService) FindAutoMessageRuns(instanceID string, autoMessageID string, limit int64) (runs []types.AutoMessageRun, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if autoMessageID != "" {
+		filter["autoMessageId"] = autoMessageID
+	}
+
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "startedAt", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cur, err := dbService.collectionRefAutoMessageRuns(instanceID).Find(
+		ctx,
+		filter,
+		opts,
+	)
+	if err != nil {
+		return runs, err
+	}
+	defer cur.Close(ctx)
+
+	runs = []types.AutoMessageRun{}
+	for cur.Next(ctx) {
+		var result types.AutoMessageRun
+		err := cur.Decode(&result)
+		if err != nil {
+			return runs, err
+		}
+
+		runs = append(runs, result)
+	}
+	if err := cur.Err(); err != nil {
+		return runs, err
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,67 @@
+package messagedb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestAutoMessageRunsDB(t *testing.T) {
+	now := time.Now().Unix()
+	testRuns := []types.AutoMessageRun{
+		{AutoMessageID: "am1", StartedAt: now - 20, Generated: 10},
+		{AutoMessageID: "am2", StartedAt: now - 15, Generated: 3},
+		{AutoMessageID: "am1", StartedAt: now - 10, Generated: 12},
+	}
+
+	t.Run("add runs", func(t *testing.T) {
+		for _, r := range testRuns {
+			r.Finish(types.MessageCounter{Success: r.Generated}, nil, r.StartedAt+2)
+			res, err := testDBService.AddAutoMessageRun(testInstanceID, r)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res.ID.IsZero() {
+				t.Error("id should be set")
+			}
+		}
+		failed := types.AutoMessageRun{AutoMessageID: "am3", StartedAt: now - 5}
+		failed.Finish(types.MessageCounter{Failed: 1}, errors.New("stream error"), now)
+		if _, err := testDBService.AddAutoMessageRun(testInstanceID, failed); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("find runs for one auto message", func(t *testing.T) {
+		res, err := testDBService.FindAutoMessageRuns(testInstanceID, "am1", 0)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of runs found: %d", len(res))
+			return
+		}
+		if res[0].Generated != 12 {
+			t.Errorf("most recent run should be first: %v", res)
+		}
+	})
+
+	t.Run("find all runs with limit", func(t *testing.T) {
+		res, err := testDBService.FindAutoMessageRuns(testInstanceID, "", 2)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of runs found: %d", len(res))
+			return
+		}
+		if res[0].AutoMessageID != "am3" || res[0].Error != "stream error" {
+			t.Errorf("unexpected run: %v", res[0])
+		}
+	})
+}
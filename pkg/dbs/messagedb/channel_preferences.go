@@ -0,0 +1,40 @@
+package messagedb
+
+import (
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveChannelPreference adds the preference of the user, or replaces the current one
+func (dbService *MessageDBService) SaveChannelPreference(instanceID string, pref types.ChannelPreference) (types.ChannelPreference, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	pref.UpdatedAt = time.Now().Unix()
+	filter := bson.M{"userID": pref.UserID}
+
+	upsert := true
+	rd := options.After
+	options := options.FindOneAndReplaceOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+	elem := types.ChannelPreference{}
+	err := dbService.collectionRefChannelPreferences(instanceID).FindOneAndReplace(
+		ctx, filter, pref, &options,
+	).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindChannelPreference(instanceID string, userID string) (types.ChannelPreference, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	elem := types.ChannelPreference{}
+	filter := bson.M{"userID": userID}
+	err := dbService.collectionRefChannelPreferences(instanceID).FindOne(ctx, filter).Decode(&elem)
+	return elem, err
+}
@@ -0,0 +1,49 @@
+package messagedb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestChannelPreferencesDB(t *testing.T) {
+	t.Run("save preference", func(t *testing.T) {
+		res, err := testDBService.SaveChannelPreference(testInstanceID, types.ChannelPreference{
+			UserID:  "pref-user1",
+			Channel: "sms",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.ID.IsZero() || res.Channel != "sms" || res.UpdatedAt <= 0 {
+			t.Errorf("unexpected result: %v", res)
+		}
+	})
+
+	t.Run("replace preference of the user", func(t *testing.T) {
+		_, err := testDBService.SaveChannelPreference(testInstanceID, types.ChannelPreference{
+			UserID:  "pref-user1",
+			Channel: "push",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		res, err := testDBService.FindChannelPreference(testInstanceID, "pref-user1")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.Channel != "push" {
+			t.Errorf("unexpected preference: %v", res)
+		}
+	})
+
+	t.Run("find preference of unknown user", func(t *testing.T) {
+		_, err := testDBService.FindChannelPreference(testInstanceID, "pref-user2")
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
@@ -2,10 +2,13 @@ package messagedb
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -14,6 +17,8 @@ type MessageDBService struct {
 	DBClient     *mongo.Client
 	timeout      int
 	DBNamePrefix string
+
+	indexedInstances sync.Map // instance IDs whose indexes were created
 }
 
 func NewMessageDBService(configs types.DBConfig) *MessageDBService {
@@ -54,10 +59,22 @@ func (dbService *MessageDBService) collectionRefEmailTemplates(instanceID string
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("email-templates")
 }
 
+func (dbService *MessageDBService) collectionRefEmailTemplateVersions(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("email-template-versions")
+}
+
+func (dbService *MessageDBService) collectionRefTemplatePartials(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("template-partials")
+}
+
 func (dbService *MessageDBService) collectionRefAutoMessages(instanceID string) *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("auto-messages")
 }
 
+func (dbService *MessageDBService) collectionRefAutoMessageRuns(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("auto-message-runs")
+}
+
 func (dbService *MessageDBService) collectionRefOutgoingEmails(instanceID string) *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("outgoing-emails")
 }
@@ -66,6 +83,137 @@ func (dbService *MessageDBService) collectionRefSentEmails(instanceID string) *m
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("sent-emails")
 }
 
+func (dbService *MessageDBService) collectionRefOutgoingSMS(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("outgoing-sms")
+}
+
+func (dbService *MessageDBService) collectionRefSentSMS(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("sent-sms")
+}
+
+func (dbService *MessageDBService) collectionRefPushSubscriptions(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("push-subscriptions")
+}
+
+func (dbService *MessageDBService) collectionRefChannelPreferences(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("channel-preferences")
+}
+
+func (dbService *MessageDBService) collectionRefOutgoingPush(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("outgoing-push")
+}
+
+func (dbService *MessageDBService) collectionRefSentPush(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("sent-push")
+}
+
+func (dbService *MessageDBService) collectionRefWebhookTargets(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("webhook-targets")
+}
+
+func (dbService *MessageDBService) collectionRefOutgoingWebhooks(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("outgoing-webhooks")
+}
+
+func (dbService *MessageDBService) collectionRefWebhookDeliveries(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("webhook-deliveries")
+}
+
+func (dbService *MessageDBService) collectionRefNotificationDigestSettings(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("notification-digest-settings")
+}
+
+func (dbService *MessageDBService) collectionRefNotificationDigestEntries(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("notification-digest-entries")
+}
+
+func (dbService *MessageDBService) collectionRefHandledNotificationRecipients(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("handled-notification-recipients")
+}
+
+func (dbService *MessageDBService) collectionRefFrequencyCap(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("frequency-cap")
+}
+
+func (dbService *MessageDBService) collectionRefCappedMessages(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_messageDB").Collection("capped-messages")
+}
+
+// CreateIndexes creates the indexes of the instance's collections, existing indexes are kept. All indexes are tried,
+// the first error is returned.
+func (dbService *MessageDBService) CreateIndexes(instanceID string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	// recent emails per address, counted for the frequency cap
+	recentEmails := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "to", Value: 1},
+			{Key: "addedAt", Value: 1},
+		},
+	}
+	indexes := []struct {
+		collection *mongo.Collection
+		model      mongo.IndexModel
+	}{
+		{
+			collection: dbService.collectionRefEmailTemplateVersions(instanceID),
+			model: mongo.IndexModel{
+				Keys: bson.D{
+					{Key: "messageType", Value: 1},
+					{Key: "studyKey", Value: 1},
+					{Key: "version", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+		{collection: dbService.collectionRefOutgoingEmails(instanceID), model: recentEmails},
+		{collection: dbService.collectionRefSentEmails(instanceID), model: recentEmails},
+	}
+
+	var firstErr error
+	for _, index := range indexes {
+		if _, err := index.collection.Indexes().CreateOne(ctx, index.model); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		dbService.indexedInstances.Store(instanceID, true)
+	}
+	return firstErr
+}
+
+// ensureIndexes creates the indexes of the instance, if they were not created by this service yet, e.g. for an
+// instance added after the start
+func (dbService *MessageDBService) ensureIndexes(instanceID string) error {
+	if _, ok := dbService.indexedInstances.Load(instanceID); ok {
+		return nil
+	}
+	return dbService.CreateIndexes(instanceID)
+}
+
+// InstanceIDs returns the IDs of the instances with a message DB, for services without access to the global DB
+func (dbService *MessageDBService) InstanceIDs() ([]string, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	names, err := dbService.DBClient.ListDatabaseNames(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	instanceIDs := []string{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, dbService.DBNamePrefix) || !strings.HasSuffix(name, "_messageDB") {
+			continue
+		}
+		instanceID := strings.TrimSuffix(strings.TrimPrefix(name, dbService.DBNamePrefix), "_messageDB")
+		if instanceID != "" {
+			instanceIDs = append(instanceIDs, instanceID)
+		}
+	}
+	return instanceIDs, nil
+}
+
 // DB utils
 func (dbService *MessageDBService) getContext() (ctx context.Context, cancel context.CancelFunc) {
 	return context.WithTimeout(context.Background(), time.Duration(dbService.timeout)*time.Second)
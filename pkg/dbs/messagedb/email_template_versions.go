@@ -0,0 +1,182 @@
+package messagedb
+
+import (
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *MessageDBService) AddEmailTemplateVersion(instanceID string, version types.EmailTemplateVersion) (types.EmailTemplateVersion, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefEmailTemplateVersions(instanceID).InsertOne(ctx, version)
+	if err != nil {
+		return version, err
+	}
+	version.ID = res.InsertedID.(primitive.ObjectID)
+	return version, nil
+}
+
+// FindEmailTemplateVersions returns the versions of a template, most recent first and without the template itself
+func (dbService *MessageDBService) FindEmailTemplateVersions(instanceID string, messageType string, studyKey string) (versions []types.EmailTemplateVersion, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "version", Value: -1}})
+	opts.SetProjection(bson.M{"template": 0})
+
+	cur, err := dbService.collectionRefEmailTemplateVersions(instanceID).Find(
+		ctx,
+		templateKeyFilter(messageType, studyKey),
+		opts,
+	)
+	if err != nil {
+		return versions, err
+	}
+	defer cur.Close(ctx)
+
+	versions = []types.EmailTemplateVersion{}
+	for cur.Next(ctx) {
+		var result types.EmailTemplateVersion
+		err := cur.Decode(&result)
+		if err != nil {
+			return versions, err
+		}
+
+		versions = append(versions, result)
+	}
+	if err := cur.Err(); err != nil {
+		return versions, err
+	}
+
+	return versions, nil
+}
+
+func (dbService *MessageDBService) FindEmailTemplateVersion(instanceID string, messageType string, studyKey string, version int) (types.EmailTemplateVersion, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := templateKeyFilter(messageType, studyKey)
+	filter["version"] = version
+
+	elem := types.EmailTemplateVersion{}
+	err := dbService.collectionRefEmailTemplateVersions(instanceID).FindOne(ctx, filter).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) deleteEmailTemplateVersion(instanceID string, id primitive.ObjectID) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefEmailTemplateVersions(instanceID).DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// maxVersionAttempts limits the retries of SaveEmailTemplateVersion if a concurrent save took the same version number
+const maxVersionAttempts = 5
+
+// FindLatestEmailTemplateVersion returns the highest version number recorded for a template, or 0 if there is none
+func (dbService *MessageDBService) FindLatestEmailTemplateVersion(instanceID string, messageType string, studyKey string) (int, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	opts := options.FindOne()
+	opts.SetSort(bson.D{{Key: "version", Value: -1}})
+	opts.SetProjection(bson.M{"version": 1})
+
+	elem := types.EmailTemplateVersion{}
+	err := dbService.collectionRefEmailTemplateVersions(instanceID).FindOne(
+		ctx,
+		templateKeyFilter(messageType, studyKey),
+		opts,
+	).Decode(&elem)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	return elem.Version, err
+}
+
+// addNextEmailTemplateVersion records the version with the next free version number. The version numbers are
+// taken from the history, so they are not reused after the template was deleted, and the unique index
+// (see CreateIndexes) makes concurrent saves retry with the following number. The index is created first if needed.
+func (dbService *MessageDBService) addNextEmailTemplateVersion(instanceID string, version types.EmailTemplateVersion) (types.EmailTemplateVersion, error) {
+	err := dbService.ensureIndexes(instanceID)
+	if err != nil {
+		return version, err
+	}
+	for i := 0; i < maxVersionAttempts; i++ {
+		var latest int
+		latest, err = dbService.FindLatestEmailTemplateVersion(instanceID, version.MessageType, version.StudyKey)
+		if err != nil {
+			return version, err
+		}
+		version.Version = latest + 1
+		version.Template.Version = version.Version
+		version, err = dbService.AddEmailTemplateVersion(instanceID, version)
+		if !mongo.IsDuplicateKeyError(err) {
+			return version, err
+		}
+	}
+	return version, err
+}
+
+// SaveEmailTemplateVersion saves the template with the next version number and records the version in the history
+func (dbService *MessageDBService) SaveEmailTemplateVersion(instanceID string, author string, templ types.EmailTemplate, rollbackOf int) (types.EmailTemplate, error) {
+	now := time.Now().Unix()
+	// the previous version is the current draft, or the published template if there is no draft
+	prev, err := dbService.FindDraftEmailTemplate(instanceID, templ.MessageType, templ.StudyKey)
+	if err != nil {
+		prev, err = dbService.FindEmailTemplateByType(instanceID, templ.MessageType, templ.StudyKey)
+	}
+	if err != nil {
+		// new template
+		prev = types.EmailTemplate{}
+	} else if prev.Version == 0 {
+		// template saved before versioning: keep it as the first version, so the edit can be undone
+		first, err := dbService.addNextEmailTemplateVersion(instanceID, types.EmailTemplateVersion{
+			MessageType: prev.MessageType,
+			StudyKey:    prev.StudyKey,
+			CreatedAt:   now,
+			Changes:     types.CompareEmailTemplates(types.EmailTemplate{}, prev),
+			Template:    prev,
+		})
+		if err != nil {
+			return templ, err
+		}
+		prev.Version = first.Version
+	}
+
+	// draft and published template are separate documents, found by message type, study key and status
+	templ.ID = primitive.NilObjectID
+	changes := types.CompareEmailTemplates(prev, templ)
+	changes.RollbackOf = rollbackOf
+	version, err := dbService.addNextEmailTemplateVersion(instanceID, types.EmailTemplateVersion{
+		MessageType: templ.MessageType,
+		StudyKey:    templ.StudyKey,
+		Author:      author,
+		CreatedAt:   now,
+		Changes:     changes,
+		Template:    templ,
+	})
+	if err != nil {
+		return templ, err
+	}
+
+	templ.Version = version.Version
+	saved, err := dbService.SaveEmailTemplate(instanceID, templ)
+	if err != nil {
+		// the version number is reserved already, remove it from the history again
+		if delErr := dbService.deleteEmailTemplateVersion(instanceID, version.ID); delErr != nil {
+			logger.Error.Printf("version %d of template %s:%s could not be removed: %v", version.Version, templ.MessageType, templ.StudyKey, delErr)
+		}
+		return saved, err
+	}
+	return saved, nil
+}
@@ -0,0 +1,162 @@
+package messagedb
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestEmailTemplateVersionsDB(t *testing.T) {
+	now := time.Now().Unix()
+	testVersions := []types.EmailTemplateVersion{
+		{MessageType: "versioned-type", Version: 1, Author: "u1", CreatedAt: now - 20, Template: types.EmailTemplate{MessageType: "versioned-type", DefaultLanguage: "en", Version: 1}},
+		{MessageType: "versioned-type", Version: 2, Author: "u2", CreatedAt: now - 10, Template: types.EmailTemplate{MessageType: "versioned-type", DefaultLanguage: "de", Version: 2}},
+		{MessageType: "versioned-type", StudyKey: "study1", Version: 1, Author: "u1", CreatedAt: now - 5, Template: types.EmailTemplate{MessageType: "versioned-type", StudyKey: "study1", Version: 1}},
+	}
+
+	t.Run("add versions", func(t *testing.T) {
+		for _, v := range testVersions {
+			res, err := testDBService.AddEmailTemplateVersion(testInstanceID, v)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res.ID.IsZero() {
+				t.Error("id should be set")
+			}
+		}
+	})
+
+	t.Run("find versions without study", func(t *testing.T) {
+		res, err := testDBService.FindEmailTemplateVersions(testInstanceID, "versioned-type", "")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of versions found: %d", len(res))
+			return
+		}
+		if res[0].Version != 2 || res[0].Author != "u2" {
+			t.Errorf("most recent version should be first: %v", res)
+		}
+		if res[0].Template.DefaultLanguage != "" {
+			t.Errorf("template should not be included: %v", res[0])
+		}
+	})
+
+	t.Run("find one version", func(t *testing.T) {
+		res, err := testDBService.FindEmailTemplateVersion(testInstanceID, "versioned-type", "", 1)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.Template.DefaultLanguage != "en" {
+			t.Errorf("unexpected version: %v", res)
+		}
+	})
+
+	t.Run("find not existing version", func(t *testing.T) {
+		_, err := testDBService.FindEmailTemplateVersion(testInstanceID, "versioned-type", "study1", 2)
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestSaveEmailTemplateVersionDB(t *testing.T) {
+	if err := testDBService.CreateIndexes(testInstanceID); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	templ := types.EmailTemplate{MessageType: "numbered-type", DefaultLanguage: "en", Status: types.EMAIL_TEMPLATE_STATUS_DRAFT}
+
+	t.Run("versions are incremented", func(t *testing.T) {
+		for i := 1; i <= 2; i++ {
+			saved, err := testDBService.SaveEmailTemplateVersion(testInstanceID, "u1", templ, 0)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if saved.Version != i {
+				t.Errorf("unexpected version: %d, expected %d", saved.Version, i)
+			}
+		}
+	})
+
+	t.Run("versions are not reused after deleting the template", func(t *testing.T) {
+		if err := testDBService.DeleteEmailTemplate(testInstanceID, templ.MessageType, templ.StudyKey); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		saved, err := testDBService.SaveEmailTemplateVersion(testInstanceID, "u1", templ, 0)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if saved.Version != 3 {
+			t.Errorf("unexpected version: %d", saved.Version)
+		}
+		latest, err := testDBService.FindLatestEmailTemplateVersion(testInstanceID, templ.MessageType, templ.StudyKey)
+		if err != nil || latest != 3 {
+			t.Errorf("unexpected latest version: %d, %v", latest, err)
+		}
+	})
+
+	t.Run("duplicate version numbers are rejected", func(t *testing.T) {
+		_, err := testDBService.AddEmailTemplateVersion(testInstanceID, types.EmailTemplateVersion{MessageType: templ.MessageType, Version: 3})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestSaveEmailTemplateVersionWithoutIndexesDB(t *testing.T) {
+	// instance without CreateIndexes, e.g. added after the start of the service
+	instanceID := testInstanceID + "-new"
+	defer func() {
+		ctx, cancel := testDBService.getContext()
+		defer cancel()
+		if err := testDBService.DBClient.Database(testDBNamePrefix + instanceID + "_messageDB").Drop(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}()
+	templ := types.EmailTemplate{MessageType: "concurrent-type", DefaultLanguage: "en", Status: types.EMAIL_TEMPLATE_STATUS_DRAFT}
+
+	t.Run("concurrent saves get different versions", func(t *testing.T) {
+		const saves = 4
+		versions := make(chan int, saves)
+		var wg sync.WaitGroup
+		for i := 0; i < saves; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				saved, err := testDBService.SaveEmailTemplateVersion(instanceID, "u1", templ, 0)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				versions <- saved.Version
+			}()
+		}
+		wg.Wait()
+		close(versions)
+
+		seen := map[int]bool{}
+		for v := range versions {
+			if seen[v] {
+				t.Errorf("version %d saved twice", v)
+			}
+			seen[v] = true
+		}
+	})
+
+	t.Run("duplicate version numbers are rejected", func(t *testing.T) {
+		_, err := testDBService.AddEmailTemplateVersion(instanceID, types.EmailTemplateVersion{MessageType: templ.MessageType, Version: 1})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
@@ -8,17 +8,34 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (dbService *MessageDBService) SaveEmailTemplate(instanceID string, template types.EmailTemplate) (types.EmailTemplate, error) {
-	ctx, cancel := dbService.getContext()
-	defer cancel()
-
+func templateKeyFilter(messageType string, studyKey string) bson.M {
 	filter := bson.M{
-		"messageType": template.MessageType,
-		"studyKey":    template.StudyKey,
+		"messageType": messageType,
+		"studyKey":    studyKey,
 	}
-	if template.StudyKey == "" {
+	if studyKey == "" {
 		filter["studyKey"] = bson.M{"$exists": false}
 	}
+	return filter
+}
+
+// templateStatusFilter selects the draft or the published template, templates without status are published
+func templateStatusFilter(messageType string, studyKey string, draft bool) bson.M {
+	filter := templateKeyFilter(messageType, studyKey)
+	if draft {
+		filter["status"] = types.EMAIL_TEMPLATE_STATUS_DRAFT
+	} else {
+		filter["status"] = bson.M{"$ne": types.EMAIL_TEMPLATE_STATUS_DRAFT}
+	}
+	return filter
+}
+
+// SaveEmailTemplate replaces the draft or the published template, depending on the status of the template
+func (dbService *MessageDBService) SaveEmailTemplate(instanceID string, template types.EmailTemplate) (types.EmailTemplate, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := templateStatusFilter(template.MessageType, template.StudyKey, template.IsDraft())
 
 	upsert := true
 	rd := options.After
@@ -33,41 +50,61 @@ func (dbService *MessageDBService) SaveEmailTemplate(instanceID string, template
 	return elem, err
 }
 
+// DeleteEmailTemplate removes the published template and its draft
 func (dbService *MessageDBService) DeleteEmailTemplate(instanceID string, messageType string, studyKey string) error {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	filter := bson.M{
-		"messageType": messageType,
-		"studyKey":    studyKey,
+	filter := templateKeyFilter(messageType, studyKey)
+	res, err := dbService.collectionRefEmailTemplates(instanceID).DeleteMany(ctx, filter)
+	if err != nil {
+		return err
 	}
-	if studyKey == "" {
-		filter["studyKey"] = bson.M{"$exists": false}
+	if res.DeletedCount < 1 {
+		err = errors.New("not found")
 	}
+	return err
+}
+
+func (dbService *MessageDBService) DeleteDraftEmailTemplate(instanceID string, messageType string, studyKey string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := templateStatusFilter(messageType, studyKey, true)
 	res, err := dbService.collectionRefEmailTemplates(instanceID).DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
 	if res.DeletedCount < 1 {
 		err = errors.New("not found")
 	}
 	return err
 }
 
+// FindEmailTemplateByType returns the published template, drafts are never used for sending
 func (dbService *MessageDBService) FindEmailTemplateByType(instanceID string, messageType string, studyKey string) (types.EmailTemplate, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	filter := bson.M{
-		"messageType": messageType,
-		"studyKey":    studyKey,
-	}
-	if studyKey == "" {
-		filter["studyKey"] = bson.M{"$exists": false}
-	}
+	filter := templateStatusFilter(messageType, studyKey, false)
+
+	elem := types.EmailTemplate{}
+	err := dbService.collectionRefEmailTemplates(instanceID).FindOne(ctx, filter).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindDraftEmailTemplate(instanceID string, messageType string, studyKey string) (types.EmailTemplate, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := templateStatusFilter(messageType, studyKey, true)
 
 	elem := types.EmailTemplate{}
 	err := dbService.collectionRefEmailTemplates(instanceID).FindOne(ctx, filter).Decode(&elem)
 	return elem, err
 }
 
+// FindAllEmailTempates returns published templates and drafts
 func (dbService *MessageDBService) FindAllEmailTempates(instanceID string) (templates []types.EmailTemplate, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -105,3 +105,74 @@ func TestEmailTemplatesDB(t *testing.T) {
 	})
 
 }
+
+func TestEmailTemplateDraftsDB(t *testing.T) {
+	published := types.EmailTemplate{
+		MessageType:     "draft-test",
+		DefaultLanguage: "en",
+	}
+	draft := types.EmailTemplate{
+		MessageType:     "draft-test",
+		DefaultLanguage: "de",
+		Status:          types.EMAIL_TEMPLATE_STATUS_DRAFT,
+	}
+
+	t.Run("save published template and draft", func(t *testing.T) {
+		if _, err := testDBService.SaveEmailTemplate(testInstanceID, published); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if _, err := testDBService.SaveEmailTemplate(testInstanceID, draft); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("find by type returns the published template", func(t *testing.T) {
+		res, err := testDBService.FindEmailTemplateByType(testInstanceID, "draft-test", "")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.DefaultLanguage != "en" || res.IsDraft() {
+			t.Errorf("unexpected template found: %v", res)
+		}
+	})
+
+	t.Run("find draft", func(t *testing.T) {
+		res, err := testDBService.FindDraftEmailTemplate(testInstanceID, "draft-test", "")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.DefaultLanguage != "de" || !res.IsDraft() {
+			t.Errorf("unexpected template found: %v", res)
+		}
+	})
+
+	t.Run("delete draft", func(t *testing.T) {
+		if err := testDBService.DeleteDraftEmailTemplate(testInstanceID, "draft-test", ""); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if _, err := testDBService.FindDraftEmailTemplate(testInstanceID, "draft-test", ""); err == nil {
+			t.Error("draft should be deleted")
+		}
+		if _, err := testDBService.FindEmailTemplateByType(testInstanceID, "draft-test", ""); err != nil {
+			t.Errorf("published template should remain: %v", err)
+		}
+	})
+
+	t.Run("delete template with draft", func(t *testing.T) {
+		if _, err := testDBService.SaveEmailTemplate(testInstanceID, draft); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.DeleteEmailTemplate(testInstanceID, "draft-test", ""); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if _, err := testDBService.FindDraftEmailTemplate(testInstanceID, "draft-test", ""); err == nil {
+			t.Error("draft should be deleted")
+		}
+	})
+}
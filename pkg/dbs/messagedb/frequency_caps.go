@@ -0,0 +1,96 @@
+package messagedb
+
+import (
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveFrequencyCap replaces the frequency cap of the instance
+func (dbService *MessageDBService) SaveFrequencyCap(instanceID string, freqCap types.FrequencyCap) (types.FrequencyCap, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+
+	upsert := true
+	rd := options.After
+	options := options.FindOneAndReplaceOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+	elem := types.FrequencyCap{}
+	err := dbService.collectionRefFrequencyCap(instanceID).FindOneAndReplace(
+		ctx, filter, freqCap, &options,
+	).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindFrequencyCap(instanceID string) (types.FrequencyCap, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	elem := types.FrequencyCap{}
+	err := dbService.collectionRefFrequencyCap(instanceID).FindOne(ctx, bson.M{}).Decode(&elem)
+	return elem, err
+}
+
+// FindRecentEmailTimes returns when the non-transactional emails to the address were sent, or will be sent if they
+// are still outgoing, for the emails since the given time
+func (dbService *MessageDBService) FindRecentEmailTimes(instanceID string, to string, since int64) ([]int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"to":          to,
+		"messageType": bson.M{"$nin": types.TRANSACTIONAL_MESSAGE_TYPES},
+		"$or": bson.A{
+			bson.M{"addedAt": bson.M{"$gt": since}},
+			bson.M{"sendAfter": bson.M{"$gt": since}},
+		},
+	}
+	opts := options.Find().SetProjection(bson.M{"addedAt": 1, "sendAfter": 1})
+
+	times := []int64{}
+	for _, coll := range []*mongo.Collection{
+		dbService.collectionRefOutgoingEmails(instanceID),
+		dbService.collectionRefSentEmails(instanceID),
+	} {
+		cur, err := coll.Find(ctx, filter, opts)
+		if err != nil {
+			return times, err
+		}
+		for cur.Next(ctx) {
+			var result types.OutgoingEmail
+			if err := cur.Decode(&result); err != nil {
+				cur.Close(ctx)
+				return times, err
+			}
+			t := result.AddedAt
+			if result.SendAfter > t {
+				t = result.SendAfter
+			}
+			times = append(times, t)
+		}
+		err = cur.Err()
+		cur.Close(ctx)
+		if err != nil {
+			return times, err
+		}
+	}
+	return times, nil
+}
+
+func (dbService *MessageDBService) AddCappedMessage(instanceID string, msg types.CappedMessage) (types.CappedMessage, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefCappedMessages(instanceID).InsertOne(ctx, msg)
+	if err != nil {
+		return msg, err
+	}
+	msg.ID = res.InsertedID.(primitive.ObjectID)
+	return msg, nil
+}
@@ -0,0 +1,98 @@
+package messagedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestFrequencyCapDB(t *testing.T) {
+	t.Run("save and replace cap", func(t *testing.T) {
+		for _, max := range []int{5, 2} {
+			_, err := testDBService.SaveFrequencyCap(testInstanceID, types.FrequencyCap{
+				MaxMessages: max,
+				Period:      types.DEFAULT_FREQUENCY_CAP_PERIOD,
+				Policy:      types.FREQUENCY_CAP_POLICY_DEFER,
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+		res, err := testDBService.FindFrequencyCap(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.MaxMessages != 2 || res.Policy != types.FREQUENCY_CAP_POLICY_DEFER {
+			t.Errorf("unexpected cap: %v", res)
+		}
+	})
+
+	t.Run("find recent email times", func(t *testing.T) {
+		to := "capped@example.org"
+		for _, messageType := range []string{"weekly", constants.EMAIL_TYPE_PASSWORD_RESET} {
+			_, err := testDBService.AddToSentEmails(testInstanceID, types.OutgoingEmail{
+				To:          []string{to},
+				MessageType: messageType,
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+		sendAfter := time.Now().Unix() + 3600
+		_, err := testDBService.AddToOutgoingEmails(testInstanceID, types.OutgoingEmail{
+			To:          []string{to},
+			MessageType: "study-reminder",
+			SendAfter:   sendAfter,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		times, err := testDBService.FindRecentEmailTimes(testInstanceID, to, time.Now().Unix()-60)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(times) != 2 || times[0] != sendAfter {
+			t.Errorf("unexpected times: %v", times)
+		}
+	})
+
+	t.Run("deferred email is not fetched", func(t *testing.T) {
+		resp, err := testDBService.FetchOutgoingEmails(testInstanceID, 100, 0, "")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		for _, email := range resp {
+			if email.SendAfter > time.Now().Unix() {
+				t.Errorf("deferred email fetched: %v", email)
+			}
+			if err := testDBService.ResetLastSendAttemptForOutgoing(testInstanceID, email.ID.Hex()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}
+	})
+
+	t.Run("record capped message", func(t *testing.T) {
+		res, err := testDBService.AddCappedMessage(testInstanceID, types.CappedMessage{
+			MessageType: "weekly",
+			To:          "capped@example.org",
+			Policy:      types.FREQUENCY_CAP_POLICY_DROP,
+			CappedAt:    time.Now().Unix(),
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.ID.IsZero() {
+			t.Errorf("unexpected result: %v", res)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package messagedb
+
+import (
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveNotificationDigestSettings adds the settings of the study, or replaces the current ones
+func (dbService *MessageDBService) SaveNotificationDigestSettings(instanceID string, settings types.NotificationDigestSettings) (types.NotificationDigestSettings, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"studyKey": settings.StudyKey}
+
+	upsert := true
+	rd := options.After
+	options := options.FindOneAndReplaceOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+	elem := types.NotificationDigestSettings{}
+	err := dbService.collectionRefNotificationDigestSettings(instanceID).FindOneAndReplace(
+		ctx, filter, settings, &options,
+	).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindNotificationDigestSettings(instanceID string, studyKey string) (types.NotificationDigestSettings, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	elem := types.NotificationDigestSettings{}
+	filter := bson.M{"studyKey": studyKey}
+	err := dbService.collectionRefNotificationDigestSettings(instanceID).FindOne(ctx, filter).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindAllNotificationDigestSettings(instanceID string) (settings []types.NotificationDigestSettings, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	cur, err := dbService.collectionRefNotificationDigestSettings(instanceID).Find(
+		ctx,
+		filter,
+	)
+	if err != nil {
+		return settings, err
+	}
+	defer cur.Close(ctx)
+
+	settings = []types.NotificationDigestSettings{}
+	for cur.Next(ctx) {
+		var result types.NotificationDigestSettings
+		err := cur.Decode(&result)
+		if err != nil {
+			return settings, err
+		}
+
+		settings = append(settings, result)
+	}
+	if err := cur.Err(); err != nil {
+		return settings, err
+	}
+
+	return settings, nil
+}
+
+func (dbService *MessageDBService) UpdateLastDigestAt(instanceID string, studyKey string, lastDigestAt int64) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"studyKey": studyKey}
+	update := bson.M{"$set": bson.M{"lastDigestAt": lastDigestAt}}
+	_, err := dbService.collectionRefNotificationDigestSettings(instanceID).UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (dbService *MessageDBService) AddNotificationDigestEntry(instanceID string, entry types.NotificationDigestEntry) (types.NotificationDigestEntry, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefNotificationDigestEntries(instanceID).InsertOne(ctx, entry)
+	if err != nil {
+		return entry, err
+	}
+	entry.ID = res.InsertedID.(primitive.ObjectID)
+	return entry, nil
+}
+
+// FindNotificationDigestEntries returns the collected entries of the study, oldest first
+func (dbService *MessageDBService) FindNotificationDigestEntries(instanceID string, studyKey string) (entries []types.NotificationDigestEntry, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"studyKey": studyKey}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+	cur, err := dbService.collectionRefNotificationDigestEntries(instanceID).Find(
+		ctx,
+		filter,
+		opts,
+	)
+	if err != nil {
+		return entries, err
+	}
+	defer cur.Close(ctx)
+
+	entries = []types.NotificationDigestEntry{}
+	for cur.Next(ctx) {
+		var result types.NotificationDigestEntry
+		err := cur.Decode(&result)
+		if err != nil {
+			return entries, err
+		}
+
+		entries = append(entries, result)
+	}
+	if err := cur.Err(); err != nil {
+		return entries, err
+	}
+
+	return entries, nil
+}
+
+func (dbService *MessageDBService) DeleteNotificationDigestEntries(instanceID string, ids []primitive.ObjectID) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	res, err := dbService.collectionRefNotificationDigestEntries(instanceID).DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
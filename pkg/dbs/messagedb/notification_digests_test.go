@@ -0,0 +1,125 @@
+package messagedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNotificationDigestSettingsDB(t *testing.T) {
+	t.Run("save settings", func(t *testing.T) {
+		for _, s := range []types.NotificationDigestSettings{
+			{StudyKey: "digest-study1", Mode: types.DIGEST_MODE_DAILY},
+			{StudyKey: "digest-study2", Mode: types.DIGEST_MODE_HOURLY},
+			{StudyKey: "digest-study3", Mode: types.DIGEST_MODE_IMMEDIATE},
+		} {
+			res, err := testDBService.SaveNotificationDigestSettings(testInstanceID, s)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res.ID.IsZero() || res.Mode != s.Mode {
+				t.Errorf("unexpected result: %v", res)
+			}
+		}
+	})
+
+	t.Run("replace settings of the study", func(t *testing.T) {
+		_, err := testDBService.SaveNotificationDigestSettings(testInstanceID, types.NotificationDigestSettings{
+			StudyKey: "digest-study2",
+			Mode:     types.DIGEST_MODE_DAILY,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		res, err := testDBService.FindNotificationDigestSettings(testInstanceID, "digest-study2")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.Mode != types.DIGEST_MODE_DAILY {
+			t.Errorf("unexpected settings: %v", res)
+		}
+	})
+
+	t.Run("find all settings", func(t *testing.T) {
+		res, err := testDBService.FindAllNotificationDigestSettings(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 3 {
+			t.Errorf("unexpected number of settings: %d", len(res))
+		}
+	})
+
+	t.Run("update last digest", func(t *testing.T) {
+		now := time.Now().Unix()
+		if err := testDBService.UpdateLastDigestAt(testInstanceID, "digest-study1", now); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		res, err := testDBService.FindNotificationDigestSettings(testInstanceID, "digest-study1")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.LastDigestAt != now || res.IsDue(time.Now()) {
+			t.Errorf("unexpected settings: %v", res)
+		}
+	})
+}
+
+func TestNotificationDigestEntriesDB(t *testing.T) {
+	ids := []primitive.ObjectID{}
+
+	t.Run("add entries", func(t *testing.T) {
+		for i, recipient := range []string{"r1@test.com", "r2@test.com", "r1@test.com"} {
+			res, err := testDBService.AddNotificationDigestEntry(testInstanceID, types.NotificationDigestEntry{
+				StudyKey:       "digest-entries",
+				Recipient:      recipient,
+				MessageType:    "participant-flagged",
+				NotificationID: "n" + recipient,
+				CreatedAt:      time.Now().Unix() - int64(10-i),
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			ids = append(ids, res.ID)
+		}
+	})
+
+	t.Run("find entries of the study", func(t *testing.T) {
+		res, err := testDBService.FindNotificationDigestEntries(testInstanceID, "digest-entries")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 3 || res[0].ID != ids[0] || res[2].ID != ids[2] {
+			t.Errorf("unexpected entries: %v", res)
+		}
+	})
+
+	t.Run("delete entries", func(t *testing.T) {
+		count, err := testDBService.DeleteNotificationDigestEntries(testInstanceID, ids[:2])
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if count != 2 {
+			t.Errorf("unexpected count: %d", count)
+		}
+		res, err := testDBService.FindNotificationDigestEntries(testInstanceID, "digest-entries")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 1 {
+			t.Errorf("unexpected entries: %v", res)
+		}
+	})
+}
@@ -36,7 +36,7 @@ func TestOutgoingEmailsDB(t *testing.T) {
 	})
 
 	t.Run("fetch outgoing emails", func(t *testing.T) {
-		resp, err := testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, true)
+		resp, err := testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, types.PRIORITY_TRANSACTIONAL)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -46,7 +46,7 @@ func TestOutgoingEmailsDB(t *testing.T) {
 			return
 		}
 
-		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, true)
+		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, types.PRIORITY_TRANSACTIONAL)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -56,7 +56,7 @@ func TestOutgoingEmailsDB(t *testing.T) {
 			return
 		}
 
-		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, false)
+		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, "")
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -66,7 +66,7 @@ func TestOutgoingEmailsDB(t *testing.T) {
 			return
 		}
 		// again:
-		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, false)
+		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, "")
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -76,4 +76,40 @@ func TestOutgoingEmailsDB(t *testing.T) {
 			return
 		}
 	})
+
+	t.Run("fetch outgoing emails by priority", func(t *testing.T) {
+		for _, priority := range []string{types.PRIORITY_STUDY_CRITICAL, types.PRIORITY_NEWSLETTER, types.PRIORITY_NEWSLETTER} {
+			email := types.OutgoingEmail{
+				To:          []string{"test@example.org"},
+				MessageType: "test",
+				Subject:     "test",
+				Content:     "<h1>test</h1>",
+			}
+			email.SetPriority(priority)
+			if _, err := testDBService.AddToOutgoingEmails(testInstanceID, email); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+
+		resp, err := testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, types.PRIORITY_NEWSLETTER)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 2 || resp[0].GetPriority() != types.PRIORITY_NEWSLETTER || resp[0].HighPrio {
+			t.Errorf("unexpected emails found: %v", resp)
+			return
+		}
+
+		resp, err = testDBService.FetchOutgoingEmails(testInstanceID, 10, 1, types.PRIORITY_STUDY_CRITICAL)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 1 || !resp[0].HighPrio {
+			t.Errorf("unexpected emails found: %v", resp)
+			return
+		}
+	})
 }
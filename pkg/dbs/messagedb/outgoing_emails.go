@@ -40,7 +40,9 @@ func (dbService *MessageDBService) AddToSentEmails(instanceID string, email type
 	return email, nil
 }
 
-func (dbService *MessageDBService) FetchOutgoingEmails(instanceID string, amount int, olderThan int64, onlyHighPrio bool) (emails []types.OutgoingEmail, err error) {
+// FetchOutgoingEmails locks and returns up to amount emails of the priority, of all priorities if it is empty. Emails
+// queued without priority are fetched with the priority derived from their highPrio flag.
+func (dbService *MessageDBService) FetchOutgoingEmails(instanceID string, amount int, olderThan int64, priority string) (emails []types.OutgoingEmail, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
@@ -48,9 +50,19 @@ func (dbService *MessageDBService) FetchOutgoingEmails(instanceID string, amount
 	for counter < amount {
 		var newEmail types.OutgoingEmail
 		update := bson.M{"$set": bson.M{"lastSendAttempt": time.Now().Unix()}}
-		filter := bson.M{"lastSendAttempt": bson.M{"$lt": time.Now().Unix() - olderThan}}
-		if onlyHighPrio {
-			filter["highPrio"] = true
+		filter := bson.M{
+			"lastSendAttempt": bson.M{"$lt": time.Now().Unix() - olderThan},
+			// emails deferred by the frequency cap
+			"sendAfter": bson.M{"$not": bson.M{"$gt": time.Now().Unix()}},
+		}
+		if priority != "" {
+			priorities := bson.A{bson.M{"priority": priority}}
+			for _, highPrio := range []bool{true, false} {
+				if types.PriorityFromHighPrio(highPrio) == priority {
+					priorities = append(priorities, bson.M{"priority": bson.M{"$exists": false}, "highPrio": highPrio})
+				}
+			}
+			filter["$or"] = priorities
 		}
 		if err := dbService.collectionRefOutgoingEmails(instanceID).FindOneAndUpdate(ctx, filter, update).Decode(&newEmail); err != nil {
 			break
@@ -0,0 +1,99 @@
+package messagedb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (dbService *MessageDBService) AddToOutgoingPush(instanceID string, push types.OutgoingPush) (types.OutgoingPush, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if push.AddedAt <= 0 {
+		push.AddedAt = time.Now().Unix()
+	}
+
+	res, err := dbService.collectionRefOutgoingPush(instanceID).InsertOne(ctx, push)
+	if err != nil {
+		return push, err
+	}
+	push.ID = res.InsertedID.(primitive.ObjectID)
+	return push, nil
+}
+
+func (dbService *MessageDBService) AddToSentPush(instanceID string, push types.OutgoingPush) (types.OutgoingPush, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	push.AddedAt = time.Now().Unix()
+	push.Body = ""
+	push.Subscription.P256dh = ""
+	push.Subscription.Auth = ""
+
+	push.ID = primitive.NilObjectID
+	res, err := dbService.collectionRefSentPush(instanceID).InsertOne(ctx, push)
+	if err != nil {
+		return push, err
+	}
+	push.ID = res.InsertedID.(primitive.ObjectID)
+	return push, nil
+}
+
+func (dbService *MessageDBService) FetchOutgoingPush(instanceID string, amount int, olderThan int64, onlyHighPrio bool) (messages []types.OutgoingPush, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	counter := 0
+	for counter < amount {
+		var newPush types.OutgoingPush
+		update := bson.M{"$set": bson.M{"lastSendAttempt": time.Now().Unix()}}
+		filter := bson.M{"lastSendAttempt": bson.M{"$lt": time.Now().Unix() - olderThan}}
+		if onlyHighPrio {
+			filter["highPrio"] = true
+		}
+		if err := dbService.collectionRefOutgoingPush(instanceID).FindOneAndUpdate(ctx, filter, update).Decode(&newPush); err != nil {
+			break
+		}
+		messages = append(messages, newPush)
+		counter += 1
+	}
+	return messages, nil
+}
+
+func (dbService *MessageDBService) ResetLastSendAttemptForOutgoingPush(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+	update := bson.M{"$set": bson.M{"lastSendAttempt": 0}}
+
+	res, err := dbService.collectionRefOutgoingPush(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount < 1 {
+		return errors.New("no outgoing push notification found with the given id")
+	}
+	return nil
+}
+
+func (dbService *MessageDBService) DeleteOutgoingPush(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+
+	res, err := dbService.collectionRefOutgoingPush(instanceID).DeleteOne(ctx, filter, nil)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		return errors.New("no outgoing push notification found with the given id")
+	}
+	return nil
+}
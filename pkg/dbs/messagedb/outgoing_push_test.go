@@ -0,0 +1,68 @@
+package messagedb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestOutgoingPushDB(t *testing.T) {
+	sub := types.PushSubscription{UserID: "user1", Endpoint: "https://push.example.org/1", P256dh: "key", Auth: "auth"}
+
+	var firstID string
+	t.Run("add outgoing push notifications", func(t *testing.T) {
+		push, err := testDBService.AddToOutgoingPush(testInstanceID, types.OutgoingPush{
+			MessageType:  "test",
+			Subscription: sub,
+			Title:        "test",
+			Body:         "test",
+			HighPrio:     true,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		firstID = push.ID.Hex()
+		for i := 0; i < 2; i++ {
+			_, err := testDBService.AddToOutgoingPush(testInstanceID, types.OutgoingPush{
+				MessageType:  "test",
+				Subscription: sub,
+				Title:        "test",
+				Body:         "test",
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+	})
+
+	t.Run("fetch outgoing push notifications", func(t *testing.T) {
+		resp, err := testDBService.FetchOutgoingPush(testInstanceID, 10, 1, false)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 3 {
+			t.Errorf("unexpected number of messages found: %d", len(resp))
+			return
+		}
+		if resp[0].Subscription.Endpoint != sub.Endpoint {
+			t.Errorf("unexpected subscription: %+v", resp[0].Subscription)
+		}
+	})
+
+	t.Run("move to sent", func(t *testing.T) {
+		sent, err := testDBService.AddToSentPush(testInstanceID, types.OutgoingPush{MessageType: "test", Subscription: sub, Body: "test"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if sent.Body != "" || sent.Subscription.Auth != "" || sent.Subscription.Endpoint == "" {
+			t.Errorf("unexpected sent message: %+v", sent)
+		}
+		if err := testDBService.DeleteOutgoingPush(testInstanceID, firstID); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
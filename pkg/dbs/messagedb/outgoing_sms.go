@@ -0,0 +1,97 @@
+package messagedb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (dbService *MessageDBService) AddToOutgoingSMS(instanceID string, sms types.OutgoingSMS) (types.OutgoingSMS, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if sms.AddedAt <= 0 {
+		sms.AddedAt = time.Now().Unix()
+	}
+
+	res, err := dbService.collectionRefOutgoingSMS(instanceID).InsertOne(ctx, sms)
+	if err != nil {
+		return sms, err
+	}
+	sms.ID = res.InsertedID.(primitive.ObjectID)
+	return sms, nil
+}
+
+func (dbService *MessageDBService) AddToSentSMS(instanceID string, sms types.OutgoingSMS) (types.OutgoingSMS, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	sms.AddedAt = time.Now().Unix()
+	sms.Text = ""
+
+	sms.ID = primitive.NilObjectID
+	res, err := dbService.collectionRefSentSMS(instanceID).InsertOne(ctx, sms)
+	if err != nil {
+		return sms, err
+	}
+	sms.ID = res.InsertedID.(primitive.ObjectID)
+	return sms, nil
+}
+
+func (dbService *MessageDBService) FetchOutgoingSMS(instanceID string, amount int, olderThan int64, onlyHighPrio bool) (messages []types.OutgoingSMS, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	counter := 0
+	for counter < amount {
+		var newSMS types.OutgoingSMS
+		update := bson.M{"$set": bson.M{"lastSendAttempt": time.Now().Unix()}}
+		filter := bson.M{"lastSendAttempt": bson.M{"$lt": time.Now().Unix() - olderThan}}
+		if onlyHighPrio {
+			filter["highPrio"] = true
+		}
+		if err := dbService.collectionRefOutgoingSMS(instanceID).FindOneAndUpdate(ctx, filter, update).Decode(&newSMS); err != nil {
+			break
+		}
+		messages = append(messages, newSMS)
+		counter += 1
+	}
+	return messages, nil
+}
+
+func (dbService *MessageDBService) ResetLastSendAttemptForOutgoingSMS(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+	update := bson.M{"$set": bson.M{"lastSendAttempt": 0}}
+
+	res, err := dbService.collectionRefOutgoingSMS(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount < 1 {
+		return errors.New("no outgoing SMS found with the given id")
+	}
+	return nil
+}
+
+func (dbService *MessageDBService) DeleteOutgoingSMS(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+
+	res, err := dbService.collectionRefOutgoingSMS(instanceID).DeleteOne(ctx, filter, nil)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		return errors.New("no outgoing SMS found with the given id")
+	}
+	return nil
+}
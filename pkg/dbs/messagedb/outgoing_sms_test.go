@@ -0,0 +1,71 @@
+package messagedb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestOutgoingSMSDB(t *testing.T) {
+	var firstID string
+	t.Run("add outgoing SMS", func(t *testing.T) {
+		sms, err := testDBService.AddToOutgoingSMS(testInstanceID, types.OutgoingSMS{
+			To:          "+4917012345678",
+			MessageType: "test",
+			Text:        "test",
+			HighPrio:    true,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		firstID = sms.ID.Hex()
+		for i := 0; i < 4; i++ {
+			_, err := testDBService.AddToOutgoingSMS(testInstanceID, types.OutgoingSMS{
+				To:          "+4917012345678",
+				MessageType: "test",
+				Text:        "test",
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+	})
+
+	t.Run("fetch outgoing SMS", func(t *testing.T) {
+		resp, err := testDBService.FetchOutgoingSMS(testInstanceID, 10, 1, true)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 1 {
+			t.Errorf("unexpected number of messages found: %d", len(resp))
+			return
+		}
+
+		resp, err = testDBService.FetchOutgoingSMS(testInstanceID, 3, 1, false)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 3 {
+			t.Errorf("unexpected number of messages found: %d", len(resp))
+			return
+		}
+	})
+
+	t.Run("reset and delete outgoing SMS", func(t *testing.T) {
+		if err := testDBService.ResetLastSendAttemptForOutgoingSMS(testInstanceID, firstID); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.DeleteOutgoingSMS(testInstanceID, firstID); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.DeleteOutgoingSMS(testInstanceID, firstID); err == nil {
+			t.Error("should return an error for a deleted SMS")
+		}
+	})
+}
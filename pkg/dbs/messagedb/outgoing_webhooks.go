@@ -0,0 +1,108 @@
+package messagedb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (dbService *MessageDBService) AddToOutgoingWebhooks(instanceID string, webhook types.OutgoingWebhook) (types.OutgoingWebhook, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if webhook.AddedAt <= 0 {
+		webhook.AddedAt = time.Now().Unix()
+	}
+
+	res, err := dbService.collectionRefOutgoingWebhooks(instanceID).InsertOne(ctx, webhook)
+	if err != nil {
+		return webhook, err
+	}
+	webhook.ID = res.InsertedID.(primitive.ObjectID)
+	return webhook, nil
+}
+
+// FetchOutgoingWebhooks locks and returns notifications that are due, i.e. not attempted within olderThan seconds and
+// not waiting for a retry
+func (dbService *MessageDBService) FetchOutgoingWebhooks(instanceID string, amount int, olderThan int64) (webhooks []types.OutgoingWebhook, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	counter := 0
+	for counter < amount {
+		var newWebhook types.OutgoingWebhook
+		now := time.Now().Unix()
+		update := bson.M{"$set": bson.M{"lastSendAttempt": now}}
+		filter := bson.M{
+			"lastSendAttempt": bson.M{"$lt": now - olderThan},
+			"nextAttempt":     bson.M{"$lte": now},
+		}
+		if err := dbService.collectionRefOutgoingWebhooks(instanceID).FindOneAndUpdate(ctx, filter, update).Decode(&newWebhook); err != nil {
+			break
+		}
+		webhooks = append(webhooks, newWebhook)
+		counter += 1
+	}
+	return webhooks, nil
+}
+
+func (dbService *MessageDBService) ResetLastSendAttemptForOutgoingWebhook(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+	update := bson.M{"$set": bson.M{"lastSendAttempt": 0}}
+
+	res, err := dbService.collectionRefOutgoingWebhooks(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount < 1 {
+		return errors.New("no outgoing webhook found with the given id")
+	}
+	return nil
+}
+
+// ScheduleRetryForOutgoingWebhook records a failed attempt, the notification is fetched again after nextAttempt
+func (dbService *MessageDBService) ScheduleRetryForOutgoingWebhook(instanceID string, id string, attempts int, nextAttempt int64) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+	update := bson.M{"$set": bson.M{
+		"attempts":        attempts,
+		"nextAttempt":     nextAttempt,
+		"lastSendAttempt": 0,
+	}}
+
+	res, err := dbService.collectionRefOutgoingWebhooks(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("no outgoing webhook found with the given id")
+	}
+	return nil
+}
+
+func (dbService *MessageDBService) DeleteOutgoingWebhook(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id}
+
+	res, err := dbService.collectionRefOutgoingWebhooks(instanceID).DeleteOne(ctx, filter, nil)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		return errors.New("no outgoing webhook found with the given id")
+	}
+	return nil
+}
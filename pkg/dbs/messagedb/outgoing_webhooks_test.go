@@ -0,0 +1,76 @@
+package messagedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestOutgoingWebhooksDB(t *testing.T) {
+	var firstID string
+	t.Run("add outgoing webhooks", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			w, err := testDBService.AddToOutgoingWebhooks(testInstanceID, types.OutgoingWebhook{
+				TargetName:  "redcap",
+				MessageType: "test",
+				Payload:     `{"type":"test"}`,
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if i == 0 {
+				firstID = w.ID.Hex()
+			}
+		}
+	})
+
+	t.Run("schedule retry", func(t *testing.T) {
+		err := testDBService.ScheduleRetryForOutgoingWebhook(testInstanceID, firstID, 1, time.Now().Unix()+60)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("fetch skips webhooks waiting for a retry", func(t *testing.T) {
+		resp, err := testDBService.FetchOutgoingWebhooks(testInstanceID, 10, 1)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 2 {
+			t.Errorf("unexpected number of webhooks found: %d", len(resp))
+			return
+		}
+		for _, w := range resp {
+			if w.ID.Hex() == firstID {
+				t.Error("webhook waiting for a retry should not be fetched")
+			}
+		}
+	})
+
+	t.Run("fetched webhooks are locked", func(t *testing.T) {
+		resp, err := testDBService.FetchOutgoingWebhooks(testInstanceID, 10, 60)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp) != 0 {
+			t.Errorf("unexpected number of webhooks found: %d", len(resp))
+		}
+	})
+
+	t.Run("reset and delete outgoing webhook", func(t *testing.T) {
+		if err := testDBService.ResetLastSendAttemptForOutgoingWebhook(testInstanceID, firstID); err == nil {
+			t.Error("should return an error if lastSendAttempt is not set")
+		}
+		if err := testDBService.DeleteOutgoingWebhook(testInstanceID, firstID); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.DeleteOutgoingWebhook(testInstanceID, firstID); err == nil {
+			t.Error("should return an error for a deleted webhook")
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package messagedb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SavePushSubscription adds the subscription, or replaces the one with the same endpoint (e.g. the browser was
+// subscribed by another user before)
+func (dbService *MessageDBService) SavePushSubscription(instanceID string, sub types.PushSubscription) (types.PushSubscription, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if sub.CreatedAt <= 0 {
+		sub.CreatedAt = time.Now().Unix()
+	}
+	filter := bson.M{"endpoint": sub.Endpoint}
+
+	upsert := true
+	rd := options.After
+	options := options.FindOneAndReplaceOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+	elem := types.PushSubscription{}
+	err := dbService.collectionRefPushSubscriptions(instanceID).FindOneAndReplace(
+		ctx, filter, sub, &options,
+	).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindPushSubscriptions(instanceID string, userID string) (subs []types.PushSubscription, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"userID": userID}
+	cur, err := dbService.collectionRefPushSubscriptions(instanceID).Find(
+		ctx,
+		filter,
+	)
+	if err != nil {
+		return subs, err
+	}
+	defer cur.Close(ctx)
+
+	subs = []types.PushSubscription{}
+	for cur.Next(ctx) {
+		var result types.PushSubscription
+		err := cur.Decode(&result)
+		if err != nil {
+			return subs, err
+		}
+
+		subs = append(subs, result)
+	}
+	if err := cur.Err(); err != nil {
+		return subs, err
+	}
+
+	return subs, nil
+}
+
+// DeletePushSubscription removes the subscription with the endpoint, if userID is not empty only if it belongs to the user
+func (dbService *MessageDBService) DeletePushSubscription(instanceID string, userID string, endpoint string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"endpoint": endpoint}
+	if userID != "" {
+		filter["userID"] = userID
+	}
+	res, err := dbService.collectionRefPushSubscriptions(instanceID).DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		err = errors.New("not found")
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+package messagedb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestPushSubscriptionsDB(t *testing.T) {
+	t.Run("save push subscriptions", func(t *testing.T) {
+		for _, sub := range []types.PushSubscription{
+			{UserID: "user1", Endpoint: "https://push.example.org/1", P256dh: "key", Auth: "auth"},
+			{UserID: "user1", Endpoint: "https://push.example.org/2", P256dh: "key", Auth: "auth"},
+			{UserID: "user2", Endpoint: "https://push.example.org/3", P256dh: "key", Auth: "auth"},
+		} {
+			saved, err := testDBService.SavePushSubscription(testInstanceID, sub)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if saved.ID.IsZero() || saved.CreatedAt == 0 {
+				t.Errorf("unexpected subscription: %+v", saved)
+			}
+		}
+	})
+
+	t.Run("save subscription of another user for the same endpoint", func(t *testing.T) {
+		_, err := testDBService.SavePushSubscription(testInstanceID, types.PushSubscription{
+			UserID: "user2", Endpoint: "https://push.example.org/2", P256dh: "key2", Auth: "auth2",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		subs, err := testDBService.FindPushSubscriptions(testInstanceID, "user1")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(subs) != 1 {
+			t.Errorf("unexpected number of subscriptions: %d", len(subs))
+		}
+	})
+
+	t.Run("delete subscription of another user", func(t *testing.T) {
+		err := testDBService.DeletePushSubscription(testInstanceID, "user1", "https://push.example.org/3")
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("delete subscription", func(t *testing.T) {
+		err := testDBService.DeletePushSubscription(testInstanceID, "user2", "https://push.example.org/3")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		subs, err := testDBService.FindPushSubscriptions(testInstanceID, "user2")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(subs) != 1 {
+			t.Errorf("unexpected number of subscriptions: %d", len(subs))
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package messagedb
+
+import (
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// AddHandledNotificationRecipient records that the notification was queued for the recipient
+func (dbService *MessageDBService) AddHandledNotificationRecipient(instanceID string, notificationID string, recipient string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefHandledNotificationRecipients(instanceID).InsertOne(ctx, types.HandledNotificationRecipient{
+		NotificationID: notificationID,
+		Recipient:      recipient,
+		HandledAt:      time.Now().Unix(),
+	})
+	return err
+}
+
+// FindHandledNotificationRecipients returns the recipients the notification was already queued for
+func (dbService *MessageDBService) FindHandledNotificationRecipients(instanceID string, notificationID string) (map[string]bool, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"notificationID": notificationID}
+	cur, err := dbService.collectionRefHandledNotificationRecipients(instanceID).Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	handled := map[string]bool{}
+	for cur.Next(ctx) {
+		var result types.HandledNotificationRecipient
+		if err := cur.Decode(&result); err != nil {
+			return nil, err
+		}
+		handled[result.Recipient] = true
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return handled, nil
+}
+
+// DeleteHandledNotificationRecipients removes the records of the notification, once it was queued for all recipients
+func (dbService *MessageDBService) DeleteHandledNotificationRecipients(instanceID string, notificationID string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"notificationID": notificationID}
+	_, err := dbService.collectionRefHandledNotificationRecipients(instanceID).DeleteMany(ctx, filter)
+	return err
+}
@@ -0,0 +1,45 @@
+package messagedb
+
+import (
+	"testing"
+)
+
+func TestHandledNotificationRecipientsDB(t *testing.T) {
+	t.Run("add recipients", func(t *testing.T) {
+		for _, r := range []string{"researcher@test.com", "webhook:redcap"} {
+			if err := testDBService.AddHandledNotificationRecipient(testInstanceID, "notification1", r); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+		if err := testDBService.AddHandledNotificationRecipient(testInstanceID, "notification2", "researcher@test.com"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("find recipients of a notification", func(t *testing.T) {
+		handled, err := testDBService.FindHandledNotificationRecipients(testInstanceID, "notification1")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(handled) != 2 || !handled["researcher@test.com"] || !handled["webhook:redcap"] {
+			t.Errorf("unexpected recipients: %v", handled)
+		}
+	})
+
+	t.Run("delete recipients of a notification", func(t *testing.T) {
+		if err := testDBService.DeleteHandledNotificationRecipients(testInstanceID, "notification1"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		handled, err := testDBService.FindHandledNotificationRecipients(testInstanceID, "notification1")
+		if err != nil || len(handled) != 0 {
+			t.Errorf("unexpected recipients: %v, %v", handled, err)
+		}
+		handled, err = testDBService.FindHandledNotificationRecipients(testInstanceID, "notification2")
+		if err != nil || len(handled) != 1 {
+			t.Errorf("recipients of other notifications should be kept: %v, %v", handled, err)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package messagedb
+
+import (
+	"errors"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *MessageDBService) SaveTemplatePartial(instanceID string, partial types.TemplatePartial) (types.TemplatePartial, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"name": partial.Name}
+
+	upsert := true
+	rd := options.After
+	options := options.FindOneAndReplaceOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+	elem := types.TemplatePartial{}
+	err := dbService.collectionRefTemplatePartials(instanceID).FindOneAndReplace(
+		ctx, filter, partial, &options,
+	).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) DeleteTemplatePartial(instanceID string, name string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"name": name}
+	res, err := dbService.collectionRefTemplatePartials(instanceID).DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		err = errors.New("not found")
+	}
+	return err
+}
+
+func (dbService *MessageDBService) FindAllTemplatePartials(instanceID string) (partials []types.TemplatePartial, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	cur, err := dbService.collectionRefTemplatePartials(instanceID).Find(
+		ctx,
+		filter,
+	)
+	if err != nil {
+		return partials, err
+	}
+	defer cur.Close(ctx)
+
+	partials = []types.TemplatePartial{}
+	for cur.Next(ctx) {
+		var result types.TemplatePartial
+		err := cur.Decode(&result)
+		if err != nil {
+			return partials, err
+		}
+
+		partials = append(partials, result)
+	}
+	if err := cur.Err(); err != nil {
+		return partials, err
+	}
+
+	return partials, nil
+}
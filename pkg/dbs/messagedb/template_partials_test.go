@@ -0,0 +1,65 @@
+package messagedb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestTemplatePartialsDB(t *testing.T) {
+	footer := types.TemplatePartial{
+		Name:            "footer",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: "Zm9vdGVy"},
+		},
+	}
+
+	t.Run("save new partial", func(t *testing.T) {
+		res, err := testDBService.SaveTemplatePartial(testInstanceID, footer)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.ID.IsZero() || res.Name != "footer" {
+			t.Errorf("unexpected result: %v", res)
+		}
+	})
+
+	t.Run("save existing partial", func(t *testing.T) {
+		footer.DefaultLanguage = "de"
+		res, err := testDBService.SaveTemplatePartial(testInstanceID, footer)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.DefaultLanguage != "de" {
+			t.Errorf("unexpected result: %v", res)
+		}
+	})
+
+	t.Run("find all partials", func(t *testing.T) {
+		if _, err := testDBService.SaveTemplatePartial(testInstanceID, types.TemplatePartial{Name: "layout"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		res, err := testDBService.FindAllTemplatePartials(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of partials found: %d", len(res))
+		}
+	})
+
+	t.Run("delete partial", func(t *testing.T) {
+		if err := testDBService.DeleteTemplatePartial(testInstanceID, "footer"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.DeleteTemplatePartial(testInstanceID, "footer"); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
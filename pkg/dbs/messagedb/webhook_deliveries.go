@@ -0,0 +1,69 @@
+package messagedb
+
+import (
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *MessageDBService) AddWebhookDelivery(instanceID string, delivery types.WebhookDelivery) (types.WebhookDelivery, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if delivery.SentAt <= 0 {
+		delivery.SentAt = time.Now().Unix()
+	}
+
+	res, err := dbService.collectionRefWebhookDeliveries(instanceID).InsertOne(ctx, delivery)
+	if err != nil {
+		return delivery, err
+	}
+	delivery.ID = res.InsertedID.(primitive.ObjectID)
+	return delivery, nil
+}
+
+// FindWebhookDeliveries returns the most recent delivery attempts first, optionally only for one target
+func (dbService *MessageDBService) FindWebhookDeliveries(instanceID string, targetName string, limit int64) (deliveries []types.WebhookDelivery, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if targetName != "" {
+		filter["targetName"] = targetName
+	}
+
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "sentAt", Value: -1}, {Key: "_id", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cur, err := dbService.collectionRefWebhookDeliveries(instanceID).Find(
+		ctx,
+		filter,
+		opts,
+	)
+	if err != nil {
+		return deliveries, err
+	}
+	defer cur.Close(ctx)
+
+	deliveries = []types.WebhookDelivery{}
+	for cur.Next(ctx) {
+		var result types.WebhookDelivery
+		err := cur.Decode(&result)
+		if err != nil {
+			return deliveries, err
+		}
+
+		deliveries = append(deliveries, result)
+	}
+	if err := cur.Err(); err != nil {
+		return deliveries, err
+	}
+
+	return deliveries, nil
+}
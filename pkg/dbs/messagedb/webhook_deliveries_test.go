@@ -0,0 +1,56 @@
+package messagedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestWebhookDeliveriesDB(t *testing.T) {
+	now := time.Now().Unix()
+	testDeliveries := []types.WebhookDelivery{
+		{TargetName: "redcap", Attempt: 1, StatusCode: 500, Error: "server error", SentAt: now - 20},
+		{TargetName: "chat", Attempt: 1, Delivered: true, StatusCode: 200, SentAt: now - 15},
+		{TargetName: "redcap", Attempt: 2, Delivered: true, StatusCode: 204, SentAt: now - 10},
+	}
+
+	t.Run("add deliveries", func(t *testing.T) {
+		for _, d := range testDeliveries {
+			res, err := testDBService.AddWebhookDelivery(testInstanceID, d)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res.ID.IsZero() {
+				t.Error("id should be set")
+			}
+		}
+	})
+
+	t.Run("find deliveries for one target", func(t *testing.T) {
+		res, err := testDBService.FindWebhookDeliveries(testInstanceID, "redcap", 0)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of deliveries found: %d", len(res))
+			return
+		}
+		if res[0].Attempt != 2 || !res[0].Delivered {
+			t.Errorf("most recent delivery should be first: %v", res)
+		}
+	})
+
+	t.Run("find all deliveries with limit", func(t *testing.T) {
+		res, err := testDBService.FindWebhookDeliveries(testInstanceID, "", 2)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of deliveries found: %d", len(res))
+		}
+	})
+}
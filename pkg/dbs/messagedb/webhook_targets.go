@@ -0,0 +1,89 @@
+package messagedb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveWebhookTarget adds the target, or replaces the one with the same name
+func (dbService *MessageDBService) SaveWebhookTarget(instanceID string, target types.WebhookTarget) (types.WebhookTarget, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if target.CreatedAt <= 0 {
+		target.CreatedAt = time.Now().Unix()
+	}
+	filter := bson.M{"name": target.Name}
+
+	upsert := true
+	rd := options.After
+	options := options.FindOneAndReplaceOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+	elem := types.WebhookTarget{}
+	err := dbService.collectionRefWebhookTargets(instanceID).FindOneAndReplace(
+		ctx, filter, target, &options,
+	).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindWebhookTarget(instanceID string, name string) (types.WebhookTarget, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	elem := types.WebhookTarget{}
+	filter := bson.M{"name": name}
+	err := dbService.collectionRefWebhookTargets(instanceID).FindOne(ctx, filter).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *MessageDBService) FindAllWebhookTargets(instanceID string) (targets []types.WebhookTarget, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	cur, err := dbService.collectionRefWebhookTargets(instanceID).Find(
+		ctx,
+		filter,
+	)
+	if err != nil {
+		return targets, err
+	}
+	defer cur.Close(ctx)
+
+	targets = []types.WebhookTarget{}
+	for cur.Next(ctx) {
+		var result types.WebhookTarget
+		err := cur.Decode(&result)
+		if err != nil {
+			return targets, err
+		}
+
+		targets = append(targets, result)
+	}
+	if err := cur.Err(); err != nil {
+		return targets, err
+	}
+
+	return targets, nil
+}
+
+func (dbService *MessageDBService) DeleteWebhookTarget(instanceID string, name string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"name": name}
+	res, err := dbService.collectionRefWebhookTargets(instanceID).DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		err = errors.New("not found")
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package messagedb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestWebhookTargetsDB(t *testing.T) {
+	t.Run("save targets", func(t *testing.T) {
+		for _, name := range []string{"redcap", "chat"} {
+			res, err := testDBService.SaveWebhookTarget(testInstanceID, types.WebhookTarget{
+				Name:   name,
+				URL:    "https://example.com/hooks/" + name,
+				Secret: "secret",
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if res.ID.IsZero() || res.CreatedAt == 0 {
+				t.Errorf("unexpected result: %v", res)
+			}
+		}
+	})
+
+	t.Run("replace target with the same name", func(t *testing.T) {
+		_, err := testDBService.SaveWebhookTarget(testInstanceID, types.WebhookTarget{
+			Name:     "chat",
+			URL:      "https://example.com/hooks/chat2",
+			Secret:   "secret2",
+			StudyKey: "study1",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		res, err := testDBService.FindWebhookTarget(testInstanceID, "chat")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.URL != "https://example.com/hooks/chat2" || res.Secret != "secret2" || res.StudyKey != "study1" {
+			t.Errorf("unexpected target: %v", res)
+		}
+	})
+
+	t.Run("find all targets", func(t *testing.T) {
+		res, err := testDBService.FindAllWebhookTargets(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res) != 2 {
+			t.Errorf("unexpected number of targets: %d", len(res))
+		}
+	})
+
+	t.Run("delete target", func(t *testing.T) {
+		if err := testDBService.DeleteWebhookTarget(testInstanceID, "redcap"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.DeleteWebhookTarget(testInstanceID, "redcap"); err == nil {
+			t.Error("should return an error for a deleted target")
+		}
+		if _, err := testDBService.FindWebhookTarget(testInstanceID, "redcap"); err == nil {
+			t.Error("should not find a deleted target")
+		}
+	})
+}
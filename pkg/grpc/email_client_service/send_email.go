@@ -29,24 +29,28 @@ func (s *emailClientServer) SendEmail(ctx context.Context, req *api.SendEmailReq
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
 
+	priority := req.Priority
+	if priority == "" {
+		priority = types.PriorityFromHighPrio(req.HighPrio)
+	}
+	// a reload during the send does not affect it
+	smtpPools := s.acquireSmtpPools()
+	defer smtpPools.Release()
+	pool := smtpPools.Config.PoolFor(req.InstanceId, req.MessageType, priority)
+	smtpClients, ok := smtpPools.Clients[pool]
+	if !ok {
+		return nil, status.Error(codes.Internal, "no SMTP pool `"+pool+"` for priority "+priority)
+	}
+
 	retryCounter := 0
 	for {
-		var err error
-		if req.HighPrio {
-			err = s.HighPrioStmpClients.SendMail(
-				req.To,
-				req.Subject,
-				req.Content,
-				types.HeaderOverridesFromEmailClientAPI(req.HeaderOverrides),
-			)
-		} else {
-			err = s.StmpClients.SendMail(
-				req.To,
-				req.Subject,
-				req.Content,
-				types.HeaderOverridesFromEmailClientAPI(req.HeaderOverrides),
-			)
-		}
+		err := smtpClients.SendMail(
+			req.To,
+			req.Subject,
+			req.Content,
+			req.TextContent,
+			types.HeaderOverridesFromEmailClientAPI(req.HeaderOverrides),
+		)
 		if err != nil {
 			if retryCounter >= maxRetry {
 				return nil, status.Error(codes.Internal, err.Error())
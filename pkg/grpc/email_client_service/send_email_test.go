@@ -6,12 +6,14 @@ import (
 
 	"github.com/influenzanet/go-utils/pkg/testutils"
 	api "github.com/influenzanet/messaging-service/pkg/api/email_client_service"
+	sc "github.com/influenzanet/messaging-service/pkg/smtp_client"
+	"github.com/influenzanet/messaging-service/pkg/types"
 )
 
 func TestSendEmailEndpoint(t *testing.T) {
-	s := emailClientServer{
-		StmpClients: nil,
-	}
+	s := NewEmailClientServiceServer(&sc.SmtpPools{
+		Config: sc.LegacySmtpPoolsConfig("smtp-servers.yaml", "high-prio-smtp-servers.yaml"),
+	})
 
 	t.Run("with missing payload", func(t *testing.T) {
 		_, err := s.SendEmail(context.Background(), nil)
@@ -42,4 +44,17 @@ func TestSendEmailEndpoint(t *testing.T) {
 			t.Error(msg)
 		}
 	})
+
+	t.Run("without pool for the priority", func(t *testing.T) {
+		_, err := s.SendEmail(context.Background(), &api.SendEmailReq{
+			To:       []string{"test@test.de"},
+			Subject:  "test",
+			Content:  "hello",
+			Priority: types.PRIORITY_NEWSLETTER,
+		})
+		ok, msg := testutils.ShouldHaveGrpcErrorStatus(err, "no SMTP pool `default` for priority newsletter")
+		if !ok {
+			t.Error(msg)
+		}
+	})
 }
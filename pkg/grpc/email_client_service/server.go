@@ -5,6 +5,8 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/coneno/logger"
 	api "github.com/influenzanet/messaging-service/pkg/api/email_client_service"
@@ -19,27 +21,53 @@ const (
 
 type emailClientServer struct {
 	api.UnimplementedEmailClientServiceApiServer
-	HighPrioStmpClients *sc.SmtpClients
-	StmpClients         *sc.SmtpClients
+	mu        sync.RWMutex
+	smtpPools *sc.SmtpPools
 }
 
 // NewEmailClientServiceServer creates a new service instance
 func NewEmailClientServiceServer(
-	hpsClients *sc.SmtpClients,
-	sClients *sc.SmtpClients,
+	smtpPools *sc.SmtpPools,
 ) api.EmailClientServiceApiServer {
 	return &emailClientServer{
-		HighPrioStmpClients: hpsClients,
-		StmpClients:         sClients,
+		smtpPools: smtpPools,
 	}
 }
 
-// RunServer runs gRPC service to publish ToDo service
+// acquireSmtpPools returns the current pools, which must be released after sending
+func (s *emailClientServer) acquireSmtpPools() *sc.SmtpPools {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.smtpPools.Acquire()
+	return s.smtpPools
+}
+
+// reloadSmtpPools replaces the pools with the loaded ones, and closes the old pools once their sends are done. If the
+// pools cannot be loaded, the current pools are kept.
+func (s *emailClientServer) reloadSmtpPools(load func() (*sc.SmtpPools, error)) error {
+	smtpPools, err := load()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	old := s.smtpPools
+	s.smtpPools = smtpPools
+	s.mu.Unlock()
+
+	old.CloseWhenIdle()
+	return nil
+}
+
+// RunServer runs gRPC service to publish ToDo service, the SMTP pools are loaded at the start and reloaded on SIGHUP
 func RunServer(
 	ctx context.Context, port string,
-	highPrioClients *sc.SmtpClients,
-	sClients *sc.SmtpClients,
+	loadSmtpPools func() (*sc.SmtpPools, error),
 ) error {
+	smtpPools, err := loadSmtpPools()
+	if err != nil {
+		return err
+	}
+
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		logger.Error.Fatalf("failed to listen: %v", err)
@@ -47,9 +75,21 @@ func RunServer(
 
 	// register service
 	server := grpc.NewServer()
-	api.RegisterEmailClientServiceApiServer(server, NewEmailClientServiceServer(
-		highPrioClients, sClients,
-	))
+	emailServer := &emailClientServer{smtpPools: smtpPools}
+	api.RegisterEmailClientServiceApiServer(server, emailServer)
+
+	// reload of the SMTP configs
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := emailServer.reloadSmtpPools(loadSmtpPools); err != nil {
+				logger.Error.Printf("SMTP config not reloaded, keeping the current config: %v", err)
+				continue
+			}
+			logger.Info.Println("SMTP config reloaded")
+		}
+	}()
 
 	// graceful shutdown
 	c := make(chan os.Signal, 1)
@@ -1 +1,48 @@
 package email_client_service
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/influenzanet/messaging-service/pkg/smtp_client"
+)
+
+func TestReloadSmtpPools(t *testing.T) {
+	current := &sc.SmtpPools{
+		Config: sc.LegacySmtpPoolsConfig("smtp-servers.yaml", "high-prio-smtp-servers.yaml"),
+	}
+	s := &emailClientServer{smtpPools: current}
+
+	t.Run("with invalid config", func(t *testing.T) {
+		err := s.reloadSmtpPools(func() (*sc.SmtpPools, error) {
+			return nil, errors.New("invalid config")
+		})
+		if err == nil {
+			t.Error("error expected")
+		}
+		if s.smtpPools != current {
+			t.Error("current pools should be kept")
+		}
+	})
+
+	t.Run("with send in flight", func(t *testing.T) {
+		inFlight := s.acquireSmtpPools()
+		reloaded := &sc.SmtpPools{
+			Config: sc.LegacySmtpPoolsConfig("smtp-servers.yaml", "high-prio-smtp-servers.yaml"),
+		}
+		err := s.reloadSmtpPools(func() (*sc.SmtpPools, error) {
+			return reloaded, nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if s.smtpPools != reloaded {
+			t.Error("pools should be replaced")
+		}
+		if inFlight != current {
+			t.Error("send in flight should keep the old pools")
+		}
+		inFlight.Release()
+	})
+}
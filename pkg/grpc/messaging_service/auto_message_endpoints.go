@@ -17,6 +17,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const defaultAutoMessageRunsLimit = 50
+
 func (s *messagingServer) GetAutoMessages(ctx context.Context, req *api.GetAutoMessagesReq) (*api.AutoMessages, error) {
 	if req == nil || token_checks.IsTokenEmpty(req.Token) {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
@@ -51,21 +53,21 @@ func (s *messagingServer) SaveAutoMessage(ctx context.Context, req *api.SaveAuto
 	}
 
 	reqMsg := types.AutoMessageFromAPI(req.AutoMessage)
-	err := templates.CheckAllTranslationsParsable(
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	err = templates.CheckAllTranslationsParsable(
+		req.Token.InstanceId,
 		reqMsg.Template,
+		partials,
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if 0 < reqMsg.Until {
-		if reqMsg.Until < time.Now().Unix() {
-			logger.Error.Println("Termination Date of auto message schedule ", time.Unix(reqMsg.Until, 0), " is outdated")
-			return nil, status.Error(codes.InvalidArgument, "invalid termination date of auto message schedule, is in past")
-		}
-		if reqMsg.Until < reqMsg.NextTime {
-			logger.Error.Println("Termination Date of auto message schedule ", time.Unix(reqMsg.Until, 0), " is earlier than Start Date ", time.Unix(reqMsg.NextTime, 0))
-			return nil, status.Error(codes.InvalidArgument, "invalid termination date of auto message schedule, earlier than start date")
-		}
+	if err := reqMsg.CheckUntil(time.Now().Unix()); err != nil {
+		logger.Error.Printf("Termination Date of auto message schedule %s (start date %s): %v", time.Unix(reqMsg.Until, 0), time.Unix(reqMsg.NextTime, 0), err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	autoMsg, err := s.messageDBservice.SaveAutoMessage(req.Token.InstanceId, *reqMsg)
 	if err != nil {
@@ -94,3 +96,31 @@ func (s *messagingServer) DeleteAutoMessage(ctx context.Context, req *api.Delete
 		Msg:    "auto message deleted",
 	}, nil
 }
+
+func (s *messagingServer) GetAutoMessageRuns(ctx context.Context, req *api.GetAutoMessageRunsReq) (*api.AutoMessageRuns, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetAutoMessageRuns, fmt.Sprintf("permission denied for auto message runs %s", req.AutoMessageId))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = defaultAutoMessageRunsLimit
+	}
+	runs, err := s.messageDBservice.FindAutoMessageRuns(req.Token.InstanceId, req.AutoMessageId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetAutoMessageRuns, req.AutoMessageId)
+	resp := &api.AutoMessageRuns{
+		Runs: make([]*api.AutoMessageRun, len(runs)),
+	}
+	for i, v := range runs {
+		resp.Runs[i] = v.ToAPI()
+	}
+	return resp, nil
+}
@@ -234,3 +234,93 @@ func TestDeleteAutoMessageEndpoint(t *testing.T) {
 		}
 	})
 }
+
+func TestGetAutoMessageRunsEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	for _, run := range []types.AutoMessageRun{
+		{AutoMessageID: "run-test-1", StartedAt: time.Now().Unix() - 10, Generated: 1243},
+		{AutoMessageID: "run-test-1", StartedAt: time.Now().Unix() - 5, Generated: 1250},
+		{AutoMessageID: "run-test-2", StartedAt: time.Now().Unix() - 5, Generated: 3},
+	} {
+		if _, err := s.messageDBservice.AddAutoMessageRun(testInstanceID, run); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.GetAutoMessageRuns(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with empty payload", func(t *testing.T) {
+		_, err := s.GetAutoMessageRuns(context.Background(), &api.GetAutoMessageRunsReq{})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with participant role", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.GetAutoMessageRuns(context.Background(), &api.GetAutoMessageRunsReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with valid arguments", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetAutoMessageRuns(context.Background(), &api.GetAutoMessageRunsReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles":    "PARTICIPANT,RESEARCHER",
+					"username": "testuser",
+				},
+			},
+			AutoMessageId: "run-test-1",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if len(resp.Runs) != 2 {
+			t.Errorf("unexpected number of runs: %d", len(resp.Runs))
+			return
+		}
+		if resp.Runs[0].Generated != 1250 {
+			t.Errorf("unexpected first run: %v", resp.Runs[0])
+		}
+	})
+}
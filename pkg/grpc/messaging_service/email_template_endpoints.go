@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/coneno/logger"
 	"github.com/influenzanet/go-utils/pkg/constants"
 	"github.com/influenzanet/go-utils/pkg/token_checks"
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/channels"
 	"github.com/influenzanet/messaging-service/pkg/templates"
 	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,17 +33,63 @@ func (s *messagingServer) GetEmailTemplates(ctx context.Context, req *api.GetEma
 	}
 
 	resp := &api.EmailTemplates{
-		Templates: make([]*api.EmailTemplate, len(templates)),
-	}
-	for i, v := range templates {
-		resp.Templates[i] = v.ToAPI()
+		Templates: emailTemplateEntries(templates),
 	}
 	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_GET_EMAIL_TEMPLATES, "")
 	return resp, nil
 }
 
+// emailTemplateEntries returns one entry per template: the published template with its draft attached, or the draft
+// if the template was never published
+func emailTemplateEntries(templates []types.EmailTemplate) []*api.EmailTemplate {
+	entries := []*api.EmailTemplate{}
+	entryIndex := map[string]int{}
+	for _, t := range templates {
+		key := t.MessageType + ":" + t.StudyKey
+		i, ok := entryIndex[key]
+		if !ok {
+			entryIndex[key] = len(entries)
+			entries = append(entries, t.ToAPI())
+			continue
+		}
+		if t.IsDraft() {
+			entries[i].Draft = t.ToAPI()
+		} else {
+			entry := t.ToAPI()
+			entry.Draft = entries[i]
+			entries[i] = entry
+		}
+	}
+	return entries
+}
+
+// checkEmailTemplate validates the template with the current partials of the instance, and returns the partials
+func (s *messagingServer) checkEmailTemplate(instanceID string, templ types.EmailTemplate) ([]types.TemplatePartial, error) {
+	partials, err := s.getTemplatePartials(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	err = templates.CheckAllTranslationsParsable(
+		instanceID,
+		templ,
+		partials,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = templates.CheckTemplateVariables(instanceID, templ, partials)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = channels.CheckRoutingRule(templ)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return partials, nil
+}
+
 func (s *messagingServer) SaveEmailTemplate(ctx context.Context, req *api.SaveEmailTemplateReq) (*api.EmailTemplate, error) {
-	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Template.MessageType == "" {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Template == nil || req.Template.MessageType == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
 	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
@@ -48,20 +97,24 @@ func (s *messagingServer) SaveEmailTemplate(ctx context.Context, req *api.SaveEm
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
+	// edits are saved as draft, until the template is published
 	templ := types.EmailTemplateFromAPI(req.Template)
-	err := templates.CheckAllTranslationsParsable(
-		templ,
-	)
+	templ.Status = types.EMAIL_TEMPLATE_STATUS_DRAFT
+	partials, err := s.checkEmailTemplate(req.Token.InstanceId, templ)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	templ, err = s.messageDBservice.SaveEmailTemplate(req.Token.InstanceId, templ)
+	templ, err = s.messageDBservice.SaveEmailTemplateVersion(req.Token.InstanceId, req.Token.Id, templ, 0)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_REMOVE_EMAIL_TEMPLATE, fmt.Sprintf("for template %s:%s", req.Template.MessageType, req.Template.StudyKey))
-	return templ.ToAPI(), nil
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_SAVE_EMAIL_TEMPLATE, fmt.Sprintf("for template %s:%s", req.Template.MessageType, req.Template.StudyKey))
+
+	// possible problems in email clients are returned as warnings, the template is saved anyway
+	resp := templ.ToAPI()
+	resp.LintWarnings = templates.LintWarningsToAPI(templates.LintTemplate(req.Token.InstanceId, templ, partials))
+	return resp, nil
 }
 
 func (s *messagingServer) DeleteEmailTemplate(ctx context.Context, req *api.DeleteEmailTemplateReq) (*api.ServiceStatus, error) {
@@ -83,3 +136,158 @@ func (s *messagingServer) DeleteEmailTemplate(ctx context.Context, req *api.Dele
 		Msg:    "template deleted",
 	}, nil
 }
+
+func (s *messagingServer) RenderEmailTemplatePreview(ctx context.Context, req *api.RenderEmailTemplatePreviewReq) (*api.EmailTemplatePreview, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || (req.Template == nil && req.MessageType == "") {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventRenderEmailTemplatePreview, fmt.Sprintf("permission denied for template %s:%s", req.MessageType, req.StudyKey))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	templ, err := s.getTemplateForPreview(req.Token.InstanceId, req.Template, req.MessageType, req.StudyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleData := templates.NewTemplateData(req.ContentInfos)
+	sampleData.AddStruct(req.ContentData)
+	preview := templates.RenderTemplatePreview(req.Token.InstanceId, templ, partials, req.Language, sampleData)
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventRenderEmailTemplatePreview, fmt.Sprintf("for template %s:%s", templ.MessageType, templ.StudyKey))
+	return preview.ToAPI(), nil
+}
+
+// getTemplateForPreview returns the unsaved template if given, otherwise the draft or, if there is none,
+// the published template of the message type
+func (s *messagingServer) getTemplateForPreview(instanceID string, unsaved *api.EmailTemplate, messageType string, studyKey string) (types.EmailTemplate, error) {
+	var templ types.EmailTemplate
+	if unsaved != nil {
+		templ = types.EmailTemplateFromAPI(unsaved)
+	} else {
+		var err error
+		templ, err = s.messageDBservice.FindDraftEmailTemplate(instanceID, messageType, studyKey)
+		if err != nil {
+			templ, err = s.messageDBservice.FindEmailTemplateByType(instanceID, messageType, studyKey)
+		}
+		if err != nil {
+			return templ, status.Error(codes.NotFound, "template not found")
+		}
+	}
+	if len(templ.Translations) == 0 {
+		return templ, status.Error(codes.InvalidArgument, "translation list is empty")
+	}
+	return templ, nil
+}
+
+func (s *messagingServer) GetEmailTemplateVersions(ctx context.Context, req *api.GetEmailTemplateVersionsReq) (*api.EmailTemplateVersions, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.MessageType == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetEmailTemplateVersions, fmt.Sprintf("permission denied for template %s:%s", req.MessageType, req.StudyKey))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	versions, err := s.messageDBservice.FindEmailTemplateVersions(req.Token.InstanceId, req.MessageType, req.StudyKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &api.EmailTemplateVersions{
+		Versions: make([]*api.EmailTemplateVersion, len(versions)),
+	}
+	for i, v := range versions {
+		resp.Versions[i] = v.ToAPI(false)
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetEmailTemplateVersions, fmt.Sprintf("for template %s:%s", req.MessageType, req.StudyKey))
+	return resp, nil
+}
+
+func (s *messagingServer) GetEmailTemplateVersion(ctx context.Context, req *api.GetEmailTemplateVersionReq) (*api.EmailTemplateVersion, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.MessageType == "" || req.Version < 1 {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetEmailTemplateVersions, fmt.Sprintf("permission denied for template %s:%s version %d", req.MessageType, req.StudyKey, req.Version))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	version, err := s.messageDBservice.FindEmailTemplateVersion(req.Token.InstanceId, req.MessageType, req.StudyKey, int(req.Version))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "version not found")
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetEmailTemplateVersions, fmt.Sprintf("for template %s:%s version %d", req.MessageType, req.StudyKey, req.Version))
+	return version.ToAPI(true), nil
+}
+
+func (s *messagingServer) RollbackEmailTemplate(ctx context.Context, req *api.RollbackEmailTemplateReq) (*api.EmailTemplate, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.MessageType == "" || req.Version < 1 {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventRollbackEmailTemplate, fmt.Sprintf("permission denied for template %s:%s version %d", req.MessageType, req.StudyKey, req.Version))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	version, err := s.messageDBservice.FindEmailTemplateVersion(req.Token.InstanceId, req.MessageType, req.StudyKey, int(req.Version))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "version not found")
+	}
+
+	// restored as draft, it has to be published again
+	templ := version.Template
+	templ.Status = types.EMAIL_TEMPLATE_STATUS_DRAFT
+	// the partials may have changed since the version was saved
+	if _, err := s.checkEmailTemplate(req.Token.InstanceId, templ); err != nil {
+		return nil, err
+	}
+	templ, err = s.messageDBservice.SaveEmailTemplateVersion(req.Token.InstanceId, req.Token.Id, templ, version.Version)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventRollbackEmailTemplate, fmt.Sprintf("for template %s:%s to version %d", req.MessageType, req.StudyKey, req.Version))
+	return templ.ToAPI(), nil
+}
+
+func (s *messagingServer) PublishEmailTemplate(ctx context.Context, req *api.PublishEmailTemplateReq) (*api.EmailTemplate, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.MessageType == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	allowedRoles := []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}
+	if s.publishTemplatesAdminOnly {
+		allowedRoles = []string{constants.USER_ROLE_ADMIN}
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, allowedRoles) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventPublishEmailTemplate, fmt.Sprintf("permission denied for template %s:%s", req.MessageType, req.StudyKey))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	templ, err := s.messageDBservice.FindDraftEmailTemplate(req.Token.InstanceId, req.MessageType, req.StudyKey)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "no draft found")
+	}
+	// the partials may have changed since the draft was saved
+	if _, err := s.checkEmailTemplate(req.Token.InstanceId, templ); err != nil {
+		return nil, err
+	}
+
+	templ.ID = primitive.NilObjectID
+	templ.Status = types.EMAIL_TEMPLATE_STATUS_PUBLISHED
+	templ, err = s.messageDBservice.SaveEmailTemplate(req.Token.InstanceId, templ)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	err = s.messageDBservice.DeleteDraftEmailTemplate(req.Token.InstanceId, req.MessageType, req.StudyKey)
+	if err != nil {
+		logger.Error.Printf("draft of published template %s:%s could not be removed: %v", req.MessageType, req.StudyKey, err)
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventPublishEmailTemplate, fmt.Sprintf("for template %s:%s version %d", req.MessageType, req.StudyKey, templ.Version))
+	return templ.ToAPI(), nil
+}
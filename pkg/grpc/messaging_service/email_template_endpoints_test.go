@@ -9,6 +9,7 @@ import (
 	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/messaging-service/pkg/types"
 	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestGetEmailTemplatesEndpoint(t *testing.T) {
@@ -33,6 +34,11 @@ func TestGetEmailTemplatesEndpoint(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 		return
 	}
+	_, err = s.messageDBservice.SaveEmailTemplate(testInstanceID, types.EmailTemplate{MessageType: "A", Status: types.EMAIL_TEMPLATE_STATUS_DRAFT})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
 
 	t.Run("without payload", func(t *testing.T) {
 		_, err := s.GetEmailTemplates(context.Background(), nil)
@@ -74,6 +80,15 @@ func TestGetEmailTemplatesEndpoint(t *testing.T) {
 			t.Errorf("unexpected number of templates: %d", len(resp.Templates))
 			return
 		}
+		for _, templ := range resp.Templates {
+			if templ.Status == types.EMAIL_TEMPLATE_STATUS_DRAFT {
+				t.Errorf("published template expected: %v", templ)
+			}
+			hasDraft := templ.Draft != nil && templ.Draft.Status == types.EMAIL_TEMPLATE_STATUS_DRAFT
+			if hasDraft != (templ.MessageType == "A") {
+				t.Errorf("unexpected draft of %s: %v", templ.MessageType, templ.Draft)
+			}
+		}
 	})
 }
 
@@ -114,6 +129,16 @@ func TestSaveEmailTemplateEndpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("without template", func(t *testing.T) {
+		_, err := s.SaveEmailTemplate(context.Background(), &api.SaveEmailTemplateReq{
+			Token: userToken,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
 	t.Run("with new template without study", func(t *testing.T) {
 		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
@@ -199,6 +224,49 @@ func TestSaveEmailTemplateEndpoint(t *testing.T) {
 			return
 		}
 	})
+
+	t.Run("with unknown variable", func(t *testing.T) {
+		_, err := s.SaveEmailTemplate(context.Background(), &api.SaveEmailTemplateReq{
+			Token: userToken,
+			Template: &api.EmailTemplate{
+				MessageType:     "registration",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					// {{.tokn}}
+					{Lang: "en", TemplateDef: "e3sudG9rbn19", Subject: ""},
+				},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown variables in template for `en`: tokn")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with lint warnings", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.SaveEmailTemplate(context.Background(), &api.SaveEmailTemplateReq{
+			Token: userToken,
+			Template: &api.EmailTemplate{
+				MessageType:     "test-lint",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					// <img src="http://example.com/logo.png">
+					{Lang: "en", TemplateDef: "PGltZyBzcmM9Imh0dHA6Ly9leGFtcGxlLmNvbS9sb2dvLnBuZyI+", Subject: ""},
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.LintWarnings) != 2 {
+			t.Errorf("unexpected warnings: %v", resp.LintWarnings)
+		}
+	})
 }
 
 func TestDeleteEmailTemplateEndpoint(t *testing.T) {
@@ -327,3 +395,442 @@ func TestDeleteEmailTemplateEndpoint(t *testing.T) {
 		}
 	})
 }
+
+func TestRenderEmailTemplatePreviewEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	_, err := s.messageDBservice.SaveEmailTemplate(testInstanceID, types.EmailTemplate{
+		MessageType:     "preview-test",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", Subject: "saved", TemplateDef: "PHA+e3submFtZX19PC9wPg=="},
+		},
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,RESEARCHER",
+			"username": "testuser",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.RenderEmailTemplatePreview(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with empty payload", func(t *testing.T) {
+		_, err := s.RenderEmailTemplatePreview(context.Background(), &api.RenderEmailTemplatePreviewReq{})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with participant role", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.RenderEmailTemplatePreview(context.Background(), &api.RenderEmailTemplatePreviewReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+			MessageType: "preview-test",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with saved template", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.RenderEmailTemplatePreview(context.Background(), &api.RenderEmailTemplatePreviewReq{
+			Token:        token,
+			MessageType:  "preview-test",
+			Language:     "en",
+			ContentInfos: map[string]string{"name": "Tester"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Errors) > 0 || resp.Subject != "saved" || resp.Content != "<p>Tester</p>" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("with structured content data", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		contentData, err := structpb.NewStruct(map[string]interface{}{
+			"surveys": []interface{}{
+				map[string]interface{}{"name": "weekly"},
+				map[string]interface{}{"name": "intake"},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		resp, err := s.RenderEmailTemplatePreview(context.Background(), &api.RenderEmailTemplatePreviewReq{
+			Token: token,
+			Template: &api.EmailTemplate{
+				MessageType:     "preview-test",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					// "<ul>{{range .surveys}}<li>{{.name}}</li>{{end}}</ul>"
+					{Lang: "en", Subject: "unsaved", TemplateDef: "PHVsPnt7cmFuZ2UgLnN1cnZleXN9fTxsaT57ey5uYW1lfX08L2xpPnt7ZW5kfX08L3VsPg=="},
+				},
+			},
+			Language:    "en",
+			ContentData: contentData,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Errors) > 0 || resp.Content != "<ul><li>weekly</li><li>intake</li></ul>" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("with unsaved template containing an error", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.RenderEmailTemplatePreview(context.Background(), &api.RenderEmailTemplatePreviewReq{
+			Token: token,
+			Template: &api.EmailTemplate{
+				MessageType:     "preview-test",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					// "<p>\n{{.name</p>"
+					{Lang: "en", Subject: "unsaved", TemplateDef: "PHA+Cnt7Lm5hbWU8L3A+"},
+				},
+			},
+			Language: "en",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Errors) != 1 || resp.Errors[0].Line != 2 {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+}
+
+func TestEmailTemplateVersionEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	userToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,RESEARCHER",
+			"username": "testuser",
+		},
+	}
+
+	for _, subject := range []string{"first", "second"} {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveEmailTemplate(context.Background(), &api.SaveEmailTemplateReq{
+			Token: userToken,
+			Template: &api.EmailTemplate{
+				MessageType:     "versioned-test",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					{Lang: "en", TemplateDef: "dGVzdA==", Subject: subject},
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+	}
+
+	t.Run("get versions without payload", func(t *testing.T) {
+		_, err := s.GetEmailTemplateVersions(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("get versions", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetEmailTemplateVersions(context.Background(), &api.GetEmailTemplateVersionsReq{
+			Token:       userToken,
+			MessageType: "versioned-test",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Versions) != 2 || resp.Versions[0].Version != 2 || resp.Versions[0].Author != "uid" {
+			t.Errorf("unexpected versions: %v", resp.Versions)
+			return
+		}
+		if len(resp.Versions[0].Changes.ChangedLanguages) != 1 {
+			t.Errorf("unexpected changes: %v", resp.Versions[0].Changes)
+		}
+	})
+
+	t.Run("get one version", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetEmailTemplateVersion(context.Background(), &api.GetEmailTemplateVersionReq{
+			Token:       userToken,
+			MessageType: "versioned-test",
+			Version:     1,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Template == nil || resp.Template.Translations[0].Subject != "first" {
+			t.Errorf("unexpected version: %v", resp)
+		}
+	})
+
+	t.Run("rollback with participant role", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.RollbackEmailTemplate(context.Background(), &api.RollbackEmailTemplateReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+			MessageType: "versioned-test",
+			Version:     1,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("rollback to not existing version", func(t *testing.T) {
+		_, err := s.RollbackEmailTemplate(context.Background(), &api.RollbackEmailTemplateReq{
+			Token:       userToken,
+			MessageType: "versioned-test",
+			Version:     10,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "version not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.RollbackEmailTemplate(context.Background(), &api.RollbackEmailTemplateReq{
+			Token:       userToken,
+			MessageType: "versioned-test",
+			Version:     1,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Version != 3 || resp.Translations[0].Subject != "first" {
+			t.Errorf("unexpected template: %v", resp)
+		}
+	})
+}
+
+func TestPublishEmailTemplateEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+		publishTemplatesAdminOnly: true,
+	}
+
+	researcherToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "RESEARCHER",
+		},
+	}
+	adminToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "RESEARCHER,ADMIN",
+		},
+	}
+
+	mockLoggingClient.EXPECT().SaveLogEvent(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+	_, err := s.SaveEmailTemplate(context.Background(), &api.SaveEmailTemplateReq{
+		Token: researcherToken,
+		Template: &api.EmailTemplate{
+			MessageType:     "publish-test",
+			DefaultLanguage: "en",
+			Translations: []*api.LocalizedTemplate{
+				{Lang: "en", TemplateDef: "dGVzdA==", Subject: "draft"},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	t.Run("draft is not used for sending", func(t *testing.T) {
+		_, err := s.messageDBservice.FindEmailTemplateByType(testInstanceID, "publish-test", "")
+		if err == nil {
+			t.Error("draft should not be found")
+		}
+	})
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.PublishEmailTemplate(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with researcher role when admin is required", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.PublishEmailTemplate(context.Background(), &api.PublishEmailTemplateReq{
+			Token:       researcherToken,
+			MessageType: "publish-test",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without draft", func(t *testing.T) {
+		_, err := s.PublishEmailTemplate(context.Background(), &api.PublishEmailTemplateReq{
+			Token:       adminToken,
+			MessageType: "not-existing",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "no draft found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with draft using a missing layout", func(t *testing.T) {
+		_, err := s.messageDBservice.SaveEmailTemplate(testInstanceID, types.EmailTemplate{
+			MessageType:     "publish-invalid-test",
+			DefaultLanguage: "en",
+			Layout:          "missing-layout",
+			Status:          types.EMAIL_TEMPLATE_STATUS_DRAFT,
+			Translations:    []types.LocalizedTemplate{{Lang: "en", TemplateDef: "dGVzdA==", Subject: "draft"}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		_, err = s.PublishEmailTemplate(context.Background(), &api.PublishEmailTemplateReq{
+			Token:       adminToken,
+			MessageType: "publish-invalid-test",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "")
+		if !ok {
+			t.Error(msg)
+		}
+		if _, err := s.messageDBservice.FindEmailTemplateByType(testInstanceID, "publish-invalid-test", ""); err == nil {
+			t.Error("invalid draft should not be published")
+		}
+	})
+
+	t.Run("with admin role", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.PublishEmailTemplate(context.Background(), &api.PublishEmailTemplateReq{
+			Token:       adminToken,
+			MessageType: "publish-test",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Status != types.EMAIL_TEMPLATE_STATUS_PUBLISHED || resp.Version != 1 {
+			t.Errorf("unexpected template: %v", resp)
+			return
+		}
+		templ, err := s.messageDBservice.FindEmailTemplateByType(testInstanceID, "publish-test", "")
+		if err != nil || templ.Translations[0].Subject != "draft" {
+			t.Errorf("published template not found: %v", err)
+		}
+		if _, err := s.messageDBservice.FindDraftEmailTemplate(testInstanceID, "publish-test", ""); err == nil {
+			t.Error("draft should be removed")
+		}
+	})
+}
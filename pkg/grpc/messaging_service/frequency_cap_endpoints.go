@@ -0,0 +1,56 @@
+package messaging_service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/go-utils/pkg/token_checks"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetFrequencyCap returns the frequency cap of the instance, a disabled cap (max messages 0) if none is saved
+func (s *messagingServer) GetFrequencyCap(ctx context.Context, req *api.GetFrequencyCapReq) (*api.FrequencyCap, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetFrequencyCap, "permission denied")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	freqCap, err := s.messageDBservice.FindFrequencyCap(req.Token.InstanceId)
+	if err != nil {
+		freqCap = types.FrequencyCap{Period: types.DEFAULT_FREQUENCY_CAP_PERIOD, Policy: types.FREQUENCY_CAP_POLICY_DEFER}
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetFrequencyCap, "")
+	return freqCap.ToAPI(), nil
+}
+
+func (s *messagingServer) SaveFrequencyCap(ctx context.Context, req *api.SaveFrequencyCapReq) (*api.FrequencyCap, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Cap == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventSaveFrequencyCap, "permission denied")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if req.Cap.MaxMessages < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max messages must not be negative")
+	}
+	if !types.IsFrequencyCapPolicy(req.Cap.Policy) {
+		return nil, status.Error(codes.InvalidArgument, "unknown frequency cap policy: "+req.Cap.Policy)
+	}
+
+	freqCap, err := s.messageDBservice.SaveFrequencyCap(req.Token.InstanceId, types.FrequencyCapFromAPI(req.Cap))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSaveFrequencyCap, fmt.Sprintf("%d per %ds, %s", freqCap.MaxMessages, freqCap.Period, freqCap.Policy))
+	return freqCap.ToAPI(), nil
+}
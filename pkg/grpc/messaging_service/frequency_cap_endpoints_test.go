@@ -0,0 +1,111 @@
+package messaging_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
+)
+
+func TestFrequencyCapEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+	adminToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,ADMIN",
+			"username": "testuser",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "testuser",
+		},
+	}
+
+	t.Run("save without cap", func(t *testing.T) {
+		_, err := s.SaveFrequencyCap(context.Background(), &api.SaveFrequencyCapReq{
+			Token: adminToken,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save as participant", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveFrequencyCap(context.Background(), &api.SaveFrequencyCapReq{
+			Token: participantToken,
+			Cap:   &api.FrequencyCap{MaxMessages: 2},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with unknown policy", func(t *testing.T) {
+		_, err := s.SaveFrequencyCap(context.Background(), &api.SaveFrequencyCapReq{
+			Token: adminToken,
+			Cap:   &api.FrequencyCap{MaxMessages: 2, Policy: "queue"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown frequency cap policy: queue")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save and get cap", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(3)
+		_, err := s.SaveFrequencyCap(context.Background(), &api.SaveFrequencyCapReq{
+			Token: adminToken,
+			Cap:   &api.FrequencyCap{MaxMessages: 2, Policy: types.FREQUENCY_CAP_POLICY_DROP},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		resp, err := s.GetFrequencyCap(context.Background(), &api.GetFrequencyCapReq{
+			Token: adminToken,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.MaxMessages != 2 || resp.Period != types.DEFAULT_FREQUENCY_CAP_PERIOD || resp.Policy != types.FREQUENCY_CAP_POLICY_DROP {
+			t.Errorf("unexpected response: %v", resp)
+		}
+
+		// disabled again for the other tests of the instance
+		_, err = s.SaveFrequencyCap(context.Background(), &api.SaveFrequencyCapReq{
+			Token: adminToken,
+			Cap:   &api.FrequencyCap{MaxMessages: 0},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -7,6 +7,31 @@ import (
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 )
 
+// log event names for endpoints without a matching constant in go-utils
+const (
+	logEventGetAutoMessageRuns         = "GET AUTO MESSAGE RUNS"
+	logEventPreviewMessageAudience     = "PREVIEW MESSAGE AUDIENCE"
+	logEventRenderEmailTemplatePreview = "RENDER EMAIL TEMPLATE PREVIEW"
+	logEventSendTestEmail              = "SEND TEST EMAIL"
+	logEventGetEmailTemplateVersions   = "GET EMAIL TEMPLATE VERSIONS"
+	logEventRollbackEmailTemplate      = "ROLLBACK EMAIL TEMPLATE"
+	logEventPublishEmailTemplate       = "PUBLISH EMAIL TEMPLATE"
+	logEventGetTemplatePartials        = "GET TEMPLATE PARTIALS"
+	logEventSaveTemplatePartial        = "SAVE TEMPLATE PARTIAL"
+	logEventDeleteTemplatePartial      = "DELETE TEMPLATE PARTIAL"
+	logEventSavePushSubscription       = "SAVE PUSH SUBSCRIPTION"
+	logEventDeletePushSubscription     = "DELETE PUSH SUBSCRIPTION"
+	logEventSaveChannelPreference      = "SAVE CHANNEL PREFERENCE"
+	logEventGetWebhookTargets          = "GET WEBHOOK TARGETS"
+	logEventSaveWebhookTarget          = "SAVE WEBHOOK TARGET"
+	logEventDeleteWebhookTarget        = "DELETE WEBHOOK TARGET"
+	logEventGetWebhookDeliveries       = "GET WEBHOOK DELIVERIES"
+	logEventGetNotificationDigest      = "GET NOTIFICATION DIGEST SETTINGS"
+	logEventSaveNotificationDigest     = "SAVE NOTIFICATION DIGEST SETTINGS"
+	logEventGetFrequencyCap            = "GET FREQUENCY CAP"
+	logEventSaveFrequencyCap           = "SAVE FREQUENCY CAP"
+)
+
 func (s *messagingServer) SaveLogEvent(
 	instanceID string,
 	userID string,
@@ -0,0 +1,56 @@
+package messaging_service
+
+import (
+	"context"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/go-utils/pkg/token_checks"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetNotificationDigestSettings returns the digest settings of the study, "immediate" if none are saved
+func (s *messagingServer) GetNotificationDigestSettings(ctx context.Context, req *api.GetNotificationDigestSettingsReq) (*api.NotificationDigestSettings, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetNotificationDigest, "permission denied for study "+req.StudyKey)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	settings, err := s.messageDBservice.FindNotificationDigestSettings(req.Token.InstanceId, req.StudyKey)
+	if err != nil {
+		settings = types.NotificationDigestSettings{StudyKey: req.StudyKey, Mode: types.DIGEST_MODE_IMMEDIATE}
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetNotificationDigest, req.StudyKey)
+	return settings.ToAPI(), nil
+}
+
+func (s *messagingServer) SaveNotificationDigestSettings(ctx context.Context, req *api.SaveNotificationDigestSettingsReq) (*api.NotificationDigestSettings, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Settings == nil || req.Settings.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventSaveNotificationDigest, "permission denied for study "+req.Settings.StudyKey)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if !types.IsDigestMode(req.Settings.Mode) {
+		return nil, status.Error(codes.InvalidArgument, "unknown digest mode: "+req.Settings.Mode)
+	}
+
+	settings := types.NotificationDigestSettingsFromAPI(req.Settings)
+	if existing, err := s.messageDBservice.FindNotificationDigestSettings(req.Token.InstanceId, settings.StudyKey); err == nil {
+		settings.LastDigestAt = existing.LastDigestAt
+	}
+	settings, err := s.messageDBservice.SaveNotificationDigestSettings(req.Token.InstanceId, settings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSaveNotificationDigest, settings.StudyKey+": "+settings.Mode)
+	return settings.ToAPI(), nil
+}
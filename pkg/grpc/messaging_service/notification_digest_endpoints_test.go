@@ -0,0 +1,121 @@
+package messaging_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
+)
+
+func TestNotificationDigestEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+	researcherToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,RESEARCHER",
+			"username": "testuser",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "testuser",
+		},
+	}
+
+	t.Run("get without study key", func(t *testing.T) {
+		_, err := s.GetNotificationDigestSettings(context.Background(), &api.GetNotificationDigestSettingsReq{
+			Token: researcherToken,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("get default settings", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetNotificationDigestSettings(context.Background(), &api.GetNotificationDigestSettingsReq{
+			Token:    researcherToken,
+			StudyKey: "digest-endpoints",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Mode != types.DIGEST_MODE_IMMEDIATE {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("save as participant", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveNotificationDigestSettings(context.Background(), &api.SaveNotificationDigestSettingsReq{
+			Token:    participantToken,
+			Settings: &api.NotificationDigestSettings{StudyKey: "digest-endpoints", Mode: types.DIGEST_MODE_DAILY},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with unknown mode", func(t *testing.T) {
+		_, err := s.SaveNotificationDigestSettings(context.Background(), &api.SaveNotificationDigestSettingsReq{
+			Token:    researcherToken,
+			Settings: &api.NotificationDigestSettings{StudyKey: "digest-endpoints", Mode: "weekly"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown digest mode: weekly")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save settings", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+		_, err := s.SaveNotificationDigestSettings(context.Background(), &api.SaveNotificationDigestSettingsReq{
+			Token:    researcherToken,
+			Settings: &api.NotificationDigestSettings{StudyKey: "digest-endpoints", Mode: types.DIGEST_MODE_HOURLY},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		resp, err := s.GetNotificationDigestSettings(context.Background(), &api.GetNotificationDigestSettingsReq{
+			Token:    researcherToken,
+			StudyKey: "digest-endpoints",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Mode != types.DIGEST_MODE_HOURLY {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+}
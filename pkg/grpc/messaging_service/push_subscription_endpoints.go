@@ -0,0 +1,106 @@
+package messaging_service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influenzanet/go-utils/pkg/token_checks"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/channels"
+	"github.com/influenzanet/messaging-service/pkg/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SavePushSubscription stores the push subscription of the browser the user is logged in with, for the user of the token
+func (s *messagingServer) SavePushSubscription(ctx context.Context, req *api.SavePushSubscriptionReq) (*api.ServiceStatus, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Subscription == nil ||
+		req.Subscription.Endpoint == "" || req.Subscription.P256Dh == "" || req.Subscription.Auth == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	if err := types.CheckPushEndpoint(req.Subscription.Endpoint); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// replace the oldest subscriptions of the user, so the new one stays within the limit
+	subs, err := s.messageDBservice.FindPushSubscriptions(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].CreatedAt < subs[j].CreatedAt
+	})
+	others := []types.PushSubscription{}
+	for _, sub := range subs {
+		if sub.Endpoint != req.Subscription.Endpoint {
+			others = append(others, sub)
+		}
+	}
+	for i := 0; i <= len(others)-types.MAX_PUSH_SUBSCRIPTIONS_PER_USER; i++ {
+		if err := s.messageDBservice.DeletePushSubscription(req.Token.InstanceId, req.Token.Id, others[i].Endpoint); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	_, err = s.messageDBservice.SavePushSubscription(req.Token.InstanceId, types.PushSubscriptionFromAPI(req.Subscription, req.Token.Id))
+	if err != nil {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_ERROR, logEventSavePushSubscription, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSavePushSubscription, "")
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "push subscription saved",
+	}, nil
+}
+
+// DeletePushSubscription removes a push subscription of the user of the token, e.g. when notifications are turned off
+func (s *messagingServer) DeletePushSubscription(ctx context.Context, req *api.DeletePushSubscriptionReq) (*api.ServiceStatus, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Endpoint == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	err := s.messageDBservice.DeletePushSubscription(req.Token.InstanceId, req.Token.Id, req.Endpoint)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventDeletePushSubscription, "")
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "push subscription deleted",
+	}, nil
+}
+
+// GetChannelPreference returns the channel the user of the token prefers, empty if none is saved
+func (s *messagingServer) GetChannelPreference(ctx context.Context, req *api.GetChannelPreferenceReq) (*api.ChannelPreference, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	pref, err := s.messageDBservice.FindChannelPreference(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		pref = types.ChannelPreference{UserID: req.Token.Id}
+	}
+	return pref.ToAPI(), nil
+}
+
+// SaveChannelPreference stores the channel the user of the token prefers to receive messages on
+func (s *messagingServer) SaveChannelPreference(ctx context.Context, req *api.SaveChannelPreferenceReq) (*api.ChannelPreference, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Preference == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if err := channels.CheckPreferredChannel(req.Preference.Channel); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pref, err := s.messageDBservice.SaveChannelPreference(req.Token.InstanceId, types.ChannelPreferenceFromAPI(req.Preference, req.Token.Id))
+	if err != nil {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_ERROR, logEventSaveChannelPreference, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSaveChannelPreference, pref.Channel)
+	return pref.ToAPI(), nil
+}
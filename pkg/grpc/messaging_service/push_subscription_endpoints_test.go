@@ -0,0 +1,230 @@
+package messaging_service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
+)
+
+func TestPushSubscriptionEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "push-uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "testuser",
+		},
+	}
+	otherToken := &api_types.TokenInfos{
+		Id:         "other-uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "other",
+		},
+	}
+	subscription := &api.PushSubscription{
+		Endpoint: "https://push.example.com/send/endpoint-test",
+		P256Dh:   "p256dh-key",
+		Auth:     "auth-secret",
+	}
+
+	t.Run("save without payload", func(t *testing.T) {
+		_, err := s.SavePushSubscription(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save without keys", func(t *testing.T) {
+		_, err := s.SavePushSubscription(context.Background(), &api.SavePushSubscriptionReq{
+			Token:        participantToken,
+			Subscription: &api.PushSubscription{Endpoint: subscription.Endpoint},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with invalid endpoint", func(t *testing.T) {
+		for _, endpoint := range []string{
+			"http://push.example.com/send/endpoint-test",
+			"push.example.com/send/endpoint-test",
+			"https://localhost/send",
+			"https://127.0.0.1/send",
+			"https://10.1.2.3:8080/send",
+			"https://[::1]/send",
+			"https://169.254.169.254/latest",
+		} {
+			_, err := s.SavePushSubscription(context.Background(), &api.SavePushSubscriptionReq{
+				Token:        participantToken,
+				Subscription: &api.PushSubscription{Endpoint: endpoint, P256Dh: subscription.P256Dh, Auth: subscription.Auth},
+			})
+			ok, msg := shouldHaveGrpcErrorStatus(err, "")
+			if !ok {
+				t.Errorf("%s: %s", endpoint, msg)
+			}
+		}
+	})
+
+	t.Run("save more subscriptions than allowed", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(types.MAX_PUSH_SUBSCRIPTIONS_PER_USER + 1)
+		for i := 0; i <= types.MAX_PUSH_SUBSCRIPTIONS_PER_USER; i++ {
+			_, err := s.SavePushSubscription(context.Background(), &api.SavePushSubscriptionReq{
+				Token:        otherToken,
+				Subscription: &api.PushSubscription{Endpoint: fmt.Sprintf("https://push.example.com/send/limit-%d", i), P256Dh: subscription.P256Dh, Auth: subscription.Auth},
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+		subs, err := testMessageDBService.FindPushSubscriptions(testInstanceID, otherToken.Id)
+		if err != nil || len(subs) != types.MAX_PUSH_SUBSCRIPTIONS_PER_USER {
+			t.Errorf("unexpected number of subscriptions: %d, %v", len(subs), err)
+		}
+	})
+
+	t.Run("save subscription", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SavePushSubscription(context.Background(), &api.SavePushSubscriptionReq{
+			Token:        participantToken,
+			Subscription: subscription,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		subs, err := testMessageDBService.FindPushSubscriptions(testInstanceID, participantToken.Id)
+		if err != nil || len(subs) != 1 || subs[0].Endpoint != subscription.Endpoint {
+			t.Errorf("unexpected subscriptions: %v, %v", subs, err)
+		}
+	})
+
+	t.Run("delete subscription of other user", func(t *testing.T) {
+		_, err := s.DeletePushSubscription(context.Background(), &api.DeletePushSubscriptionReq{
+			Token:    otherToken,
+			Endpoint: subscription.Endpoint,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("delete subscription", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.DeletePushSubscription(context.Background(), &api.DeletePushSubscriptionReq{
+			Token:    participantToken,
+			Endpoint: subscription.Endpoint,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestChannelPreferenceEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "channel-pref-uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "testuser",
+		},
+	}
+
+	t.Run("get without payload", func(t *testing.T) {
+		_, err := s.GetChannelPreference(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("get without saved preference", func(t *testing.T) {
+		resp, err := s.GetChannelPreference(context.Background(), &api.GetChannelPreferenceReq{Token: participantToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Channel != "" {
+			t.Errorf("unexpected preference: %v", resp)
+		}
+	})
+
+	t.Run("save without preference", func(t *testing.T) {
+		_, err := s.SaveChannelPreference(context.Background(), &api.SaveChannelPreferenceReq{Token: participantToken})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with unknown channel", func(t *testing.T) {
+		_, err := s.SaveChannelPreference(context.Background(), &api.SaveChannelPreferenceReq{
+			Token:      participantToken,
+			Preference: &api.ChannelPreference{Channel: "webhook"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown channel `webhook`")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save preference", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveChannelPreference(context.Background(), &api.SaveChannelPreferenceReq{
+			Token:      participantToken,
+			Preference: &api.ChannelPreference{Channel: "sms"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		resp, err := s.GetChannelPreference(context.Background(), &api.GetChannelPreferenceReq{Token: participantToken})
+		if err != nil || resp.Channel != "sms" {
+			t.Errorf("unexpected preference: %v, %v", resp, err)
+		}
+	})
+}
@@ -2,7 +2,6 @@ package messaging_service
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 
 	"github.com/coneno/logger"
@@ -15,11 +14,14 @@ import (
 	"github.com/influenzanet/messaging-service/pkg/bulk_messages"
 	"github.com/influenzanet/messaging-service/pkg/templates"
 	"github.com/influenzanet/messaging-service/pkg/types"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const testEmailSubjectPrefix = "[TEST] "
+
 func (s *messagingServer) Status(ctx context.Context, _ *empty.Empty) (*api.ServiceStatus, error) {
 	return &api.ServiceStatus{
 		Status:  api.ServiceStatus_NORMAL,
@@ -81,38 +83,148 @@ func (s *messagingServer) SendMessageToStudyParticipants(ctx context.Context, re
 	}, nil
 }
 
+func (s *messagingServer) PreviewMessageAudience(ctx context.Context, req *api.PreviewMessageAudienceReq) (*api.MessageAudiencePreview, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Template == nil || req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if req.Type == "study-participants" && req.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	allowedRoles := []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}
+	if req.Type == "all-users" {
+		allowedRoles = []string{constants.USER_ROLE_ADMIN}
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, allowedRoles) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventPreviewMessageAudience, fmt.Sprintf("permission denied for preview of %s (%s)", req.Template.MessageType, req.Type))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	template := types.EmailTemplateFromAPI(req.Template)
+	if req.Type == "study-participants" {
+		template.StudyKey = req.StudyKey
+	}
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	preview, err := bulk_messages.PreviewAudience(
+		s.clients,
+		s.messageDBservice,
+		req.Token.InstanceId,
+		req.Type,
+		template,
+		partials,
+		req.Condition,
+		req.IgnoreWeekday,
+		int(req.SampleCount),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventPreviewMessageAudience, fmt.Sprintf("%s (%s)", req.Template.MessageType, req.Type))
+	return preview, nil
+}
+
+func (s *messagingServer) SendTestEmail(ctx context.Context, req *api.SendTestEmailReq) (*api.ServiceStatus, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || (req.Template == nil && req.MessageType == "") {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventSendTestEmail, fmt.Sprintf("permission denied for template %s:%s", req.MessageType, req.StudyKey))
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	templ, err := s.getTemplateForPreview(req.Token.InstanceId, req.Template, req.MessageType, req.StudyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// test emails are only sent to the caller's own address
+	user, err := s.clients.UserManagementService.GetUser(ctx, &umAPI.UserReference{
+		Token:  req.Token,
+		UserId: req.Token.Id,
+	})
+	if err != nil {
+		logger.Error.Printf("SendTestEmail: could not fetch user %s: %v", req.Token.Id, err)
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+	if user.Account == nil || user.Account.Type != "email" || user.Account.AccountId == "" {
+		return nil, status.Error(codes.FailedPrecondition, "account has no email address")
+	}
+
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	sampleData := templates.NewTemplateData(req.ContentInfos)
+	sampleData.AddStruct(req.ContentData)
+	preview := templates.RenderTemplatePreview(req.Token.InstanceId, templ, partials, req.Language, sampleData)
+	if len(preview.Errors) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "content could not be generated: "+preview.Errors[0].Msg)
+	}
+
+	// not added to sent or outgoing emails, to keep test messages out of the statistics
+	_, err = s.clients.EmailClientService.SendEmail(ctx, &emailAPI.SendEmailReq{
+		To:              []string{user.Account.AccountId},
+		HeaderOverrides: templ.HeaderOverrides.ToEmailClientAPI(),
+		Subject:         testEmailSubjectPrefix + preview.Subject,
+		Content:         preview.Content,
+		TextContent:     preview.TextContent,
+		HighPrio:        true,
+		Priority:        types.PRIORITY_TRANSACTIONAL,
+		InstanceId:      req.Token.InstanceId,
+		MessageType:     templ.MessageType,
+	})
+	if err != nil {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_ERROR, logEventSendTestEmail, fmt.Sprintf("for template %s:%s: %v", templ.MessageType, templ.StudyKey, err))
+		return nil, status.Error(codes.Internal, "failed sending message")
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSendTestEmail, fmt.Sprintf("for template %s:%s", templ.MessageType, templ.StudyKey))
+	return &api.ServiceStatus{
+		Version: apiVersion,
+		Msg:     "test message sent",
+		Status:  api.ServiceStatus_NORMAL,
+	}, nil
+}
+
 func (s *messagingServer) SendInstantEmail(ctx context.Context, req *api.SendEmailReq) (*api.ServiceStatus, error) {
 	if req == nil || req.InstanceId == "" || len(req.To) < 1 || req.MessageType == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	priority, err := emailPriority(req)
+	if err != nil {
+		return nil, err
+	}
 
 	templateDef, err := s.messageDBservice.FindEmailTemplateByType(req.InstanceId, req.MessageType, req.StudyKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "template not found")
 	}
 
-	translation := templates.GetTemplateTranslation(templateDef, req.PreferredLanguage)
+	translation := templates.GetTemplateTranslation(req.InstanceId, templateDef, req.PreferredLanguage)
 
-	decodedTemplate, err := base64.StdEncoding.DecodeString(translation.TemplateDef)
+	data := templates.NewTemplateData(req.ContentInfos)
+	data.AddStruct(req.ContentData)
+	data.AddStrings(templates.LoadGlobalEmailTemplateConstants())
+	data["language"] = req.PreferredLanguage
+	partials, err := s.getTemplatePartials(req.InstanceId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-
-	if req.ContentInfos == nil {
-		req.ContentInfos = map[string]string{}
-	}
-	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
-	for k, v := range globalTemplateInfos {
-		req.ContentInfos[k] = v
+	shared, err := templates.NewSharedTemplates(req.InstanceId, partials, templateDef.Layout, req.PreferredLanguage)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-
-	req.ContentInfos["language"] = req.PreferredLanguage
 	// execute template
 	templateName := req.InstanceId + req.MessageType + req.PreferredLanguage
-	content, err := templates.ResolveTemplate(
+	email, err := templates.ResolveTranslation(
 		templateName,
-		string(decodedTemplate),
-		req.ContentInfos,
+		templateDef,
+		translation,
+		shared,
+		data,
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "content could not be generated")
@@ -122,17 +234,23 @@ func (s *messagingServer) SendInstantEmail(ctx context.Context, req *api.SendEma
 		MessageType:     req.MessageType,
 		To:              req.To,
 		HeaderOverrides: templateDef.HeaderOverrides,
-		Subject:         translation.Subject,
-		Content:         content,
-		HighPrio:        !req.UseLowPrio,
+		Subject:         email.Subject,
+		Content:         email.Content,
+		TextContent:     email.TextContent,
+		TemplateVersion: templateDef.Version,
 	}
+	outgoingEmail.SetPriority(priority)
 
 	_, err = s.clients.EmailClientService.SendEmail(ctx, &emailAPI.SendEmailReq{
 		To:              outgoingEmail.To,
 		HeaderOverrides: outgoingEmail.HeaderOverrides.ToEmailClientAPI(),
 		Subject:         outgoingEmail.Subject,
-		Content:         content,
-		HighPrio:        !req.UseLowPrio,
+		Content:         outgoingEmail.Content,
+		TextContent:     outgoingEmail.TextContent,
+		HighPrio:        outgoingEmail.HighPrio,
+		Priority:        outgoingEmail.Priority,
+		InstanceId:      req.InstanceId,
+		MessageType:     outgoingEmail.MessageType,
 	})
 	if err != nil {
 		_, errS := s.messageDBservice.AddToOutgoingEmails(req.InstanceId, outgoingEmail)
@@ -162,34 +280,38 @@ func (s *messagingServer) QueueEmailTemplateForSending(ctx context.Context, req
 	if req == nil || req.InstanceId == "" || len(req.To) < 1 || req.MessageType == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	priority, err := emailPriority(req)
+	if err != nil {
+		return nil, err
+	}
 
 	templateDef, err := s.messageDBservice.FindEmailTemplateByType(req.InstanceId, req.MessageType, req.StudyKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "template not found")
 	}
 
-	translation := templates.GetTemplateTranslation(templateDef, req.PreferredLanguage)
+	translation := templates.GetTemplateTranslation(req.InstanceId, templateDef, req.PreferredLanguage)
 
-	decodedTemplate, err := base64.StdEncoding.DecodeString(translation.TemplateDef)
+	data := templates.NewTemplateData(req.ContentInfos)
+	data.AddStruct(req.ContentData)
+	data.AddStrings(templates.LoadGlobalEmailTemplateConstants())
+	data["language"] = req.PreferredLanguage
+	partials, err := s.getTemplatePartials(req.InstanceId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-
-	if req.ContentInfos == nil {
-		req.ContentInfos = map[string]string{}
-	}
-	globalTemplateInfos := templates.LoadGlobalEmailTemplateConstants()
-	for k, v := range globalTemplateInfos {
-		req.ContentInfos[k] = v
+	shared, err := templates.NewSharedTemplates(req.InstanceId, partials, templateDef.Layout, req.PreferredLanguage)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-
-	req.ContentInfos["language"] = req.PreferredLanguage
 	// execute template
 	templateName := req.InstanceId + req.MessageType + req.PreferredLanguage
-	content, err := templates.ResolveTemplate(
+	email, err := templates.ResolveTranslation(
 		templateName,
-		string(decodedTemplate),
-		req.ContentInfos,
+		templateDef,
+		translation,
+		shared,
+		data,
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "content could not be generated")
@@ -199,10 +321,12 @@ func (s *messagingServer) QueueEmailTemplateForSending(ctx context.Context, req
 		MessageType:     req.MessageType,
 		To:              req.To,
 		HeaderOverrides: templateDef.HeaderOverrides,
-		Subject:         translation.Subject,
-		Content:         content,
-		HighPrio:        !req.UseLowPrio,
+		Subject:         email.Subject,
+		Content:         email.Content,
+		TextContent:     email.TextContent,
+		TemplateVersion: templateDef.Version,
 	}
+	outgoingEmail.SetPriority(priority)
 
 	_, err = s.messageDBservice.AddToOutgoingEmails(req.InstanceId, outgoingEmail)
 	if err != nil {
@@ -220,3 +344,14 @@ func (s *messagingServer) QueueEmailTemplateForSending(ctx context.Context, req
 		Status:  api.ServiceStatus_NORMAL,
 	}, nil
 }
+
+// emailPriority returns the priority of the request, or the one of use_low_prio if it has none
+func emailPriority(req *api.SendEmailReq) (string, error) {
+	if req.Priority == "" {
+		return types.PriorityFromHighPrio(!req.UseLowPrio), nil
+	}
+	if !types.IsPriority(req.Priority) {
+		return "", status.Error(codes.InvalidArgument, "unknown priority: "+req.Priority)
+	}
+	return req.Priority, nil
+}
@@ -6,12 +6,15 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/influenzanet/go-utils/pkg/api_types"
+	emailAPI "github.com/influenzanet/messaging-service/pkg/api/email_client_service"
 	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/messaging-service/pkg/types"
 	emailMock "github.com/influenzanet/messaging-service/test/mocks/email-client-service"
 	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
 	studyMock "github.com/influenzanet/messaging-service/test/mocks/study-service"
 	userMock "github.com/influenzanet/messaging-service/test/mocks/user-management-service"
+	umAPI "github.com/influenzanet/user-management-service/pkg/api"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -76,6 +79,83 @@ func TestSendMessageToAllUsersEndpoint(t *testing.T) {
 	})
 }
 
+func TestPreviewMessageAudienceEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockUserClient := userMock.NewMockUserManagementApiClient(mockCtrl)
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			UserManagementService: mockUserClient,
+			LoggingService:        mockLoggingClient,
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.PreviewMessageAudience(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with empty payload", func(t *testing.T) {
+		_, err := s.PreviewMessageAudience(context.Background(), &api.PreviewMessageAudienceReq{})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("study participants without study key", func(t *testing.T) {
+		_, err := s.PreviewMessageAudience(context.Background(), &api.PreviewMessageAudienceReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "RESEARCHER",
+				},
+			},
+			Type:     "study-participants",
+			Template: &api.EmailTemplate{MessageType: "newsletter"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("all users with researcher role", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.PreviewMessageAudience(context.Background(), &api.PreviewMessageAudienceReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles":    "PARTICIPANT,RESEARCHER",
+					"username": "testuser",
+				},
+			},
+			Type: "all-users",
+			Template: &api.EmailTemplate{
+				MessageType: "newsletter",
+				Translations: []*api.LocalizedTemplate{
+					{Lang: "en", Subject: "test", TemplateDef: "dGVzdA=="},
+				},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
+
 func TestSendMessageToStudyParticipantsEndpoint(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -141,6 +221,114 @@ func TestSendMessageToStudyParticipantsEndpoint(t *testing.T) {
 	})
 }
 
+func TestSendTestEmailEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEmailClient := emailMock.NewMockEmailClientServiceApiClient(mockCtrl)
+	mockUserClient := userMock.NewMockUserManagementApiClient(mockCtrl)
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			EmailClientService:    mockEmailClient,
+			UserManagementService: mockUserClient,
+			LoggingService:        mockLoggingClient,
+		},
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "RESEARCHER",
+			"username": "researcher@test.test",
+		},
+	}
+	testTemplate := &api.EmailTemplate{
+		MessageType:     "newsletter",
+		DefaultLanguage: "en",
+		Translations: []*api.LocalizedTemplate{
+			{Lang: "en", Subject: "test", TemplateDef: "dGVzdA=="},
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.SendTestEmail(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with empty payload", func(t *testing.T) {
+		_, err := s.SendTestEmail(context.Background(), &api.SendTestEmailReq{})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with participant role", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SendTestEmail(context.Background(), &api.SendTestEmailReq{
+			Token: &api_types.TokenInfos{
+				Id:         "uid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+			Template: testTemplate,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with valid arguments", func(t *testing.T) {
+		mockUserClient.EXPECT().GetUser(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(&umAPI.User{
+			Id: "uid",
+			Account: &umAPI.User_Account{
+				Type:      "email",
+				AccountId: "researcher@test.test",
+			},
+		}, nil)
+		mockEmailClient.EXPECT().SendEmail(
+			gomock.Any(),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, req *emailAPI.SendEmailReq, opts ...grpc.CallOption) (*emailAPI.ServiceStatus, error) {
+			if len(req.To) != 1 || req.To[0] != "researcher@test.test" {
+				t.Errorf("unexpected recipients: %v", req.To)
+			}
+			if req.Subject != "[TEST] test" || req.Content != "test" {
+				t.Errorf("unexpected message: %s - %s", req.Subject, req.Content)
+			}
+			return &emailAPI.ServiceStatus{}, nil
+		})
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		_, err := s.SendTestEmail(context.Background(), &api.SendTestEmailReq{
+			Token:    token,
+			Template: testTemplate,
+			Language: "en",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
 func TestSendInstantEmailEndpoint(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -191,6 +379,19 @@ func TestSendInstantEmailEndpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("with unknown priority", func(t *testing.T) {
+		_, err := s.SendInstantEmail(context.Background(), &api.SendEmailReq{
+			InstanceId:  testInstanceID,
+			To:          []string{"test@test.test"},
+			MessageType: "test-type",
+			Priority:    "urgent",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown priority: urgent")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
 	t.Run("with with sending failing", func(t *testing.T) {
 		mockEmailClient.EXPECT().SendEmail(
 			gomock.Any(),
@@ -208,7 +409,7 @@ func TestSendInstantEmailEndpoint(t *testing.T) {
 			return
 		}
 
-		mails, err := s.messageDBservice.FetchOutgoingEmails(testInstanceID, 1, 90, false)
+		mails, err := s.messageDBservice.FetchOutgoingEmails(testInstanceID, 1, 90, types.PRIORITY_TRANSACTIONAL)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -235,7 +436,7 @@ func TestSendInstantEmailEndpoint(t *testing.T) {
 			return
 		}
 
-		mails, err := s.messageDBservice.FetchOutgoingEmails(testInstanceID, 1, 90, false)
+		mails, err := s.messageDBservice.FetchOutgoingEmails(testInstanceID, 1, 90, "")
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
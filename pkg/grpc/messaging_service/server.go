@@ -23,16 +23,22 @@ type messagingServer struct {
 	clients          *types.APIClients
 	messageDBservice *messagedb.MessageDBService
 	//globalDBService  *globaldb.GlobalDBService
+	publishTemplatesAdminOnly bool
+	allowHTTPWebhooks         bool // for local testing, webhook targets have to use https otherwise
 }
 
 // NewMessagingServiceServer creates a new service instance
 func NewMessagingServiceServer(
 	clients *types.APIClients,
 	messageDBservice *messagedb.MessageDBService,
+	publishTemplatesAdminOnly bool,
+	allowHTTPWebhooks bool,
 ) api.MessagingServiceApiServer {
 	return &messagingServer{
-		clients:          clients,
-		messageDBservice: messageDBservice,
+		clients:                   clients,
+		messageDBservice:          messageDBservice,
+		publishTemplatesAdminOnly: publishTemplatesAdminOnly,
+		allowHTTPWebhooks:         allowHTTPWebhooks,
 	}
 }
 
@@ -40,6 +46,8 @@ func NewMessagingServiceServer(
 func RunServer(ctx context.Context, port string,
 	clients *types.APIClients,
 	messageDBservice *messagedb.MessageDBService,
+	publishTemplatesAdminOnly bool,
+	allowHTTPWebhooks bool,
 ) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
@@ -51,6 +59,8 @@ func RunServer(ctx context.Context, port string,
 	api.RegisterMessagingServiceApiServer(server, NewMessagingServiceServer(
 		clients,
 		messageDBservice,
+		publishTemplatesAdminOnly,
+		allowHTTPWebhooks,
 	))
 
 	// graceful shutdown
@@ -0,0 +1,188 @@
+package messaging_service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/go-utils/pkg/token_checks"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/templates"
+	"github.com/influenzanet/messaging-service/pkg/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getTemplatePartials loads the partials templates of the instance are resolved with
+func (s *messagingServer) getTemplatePartials(instanceID string) ([]types.TemplatePartial, error) {
+	partials, err := s.messageDBservice.FindAllTemplatePartials(instanceID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return partials, nil
+}
+
+// partialDependent is an email template or the template of an auto message, with a name for error messages
+type partialDependent struct {
+	name     string
+	template types.EmailTemplate
+}
+
+// findPartialDependents returns the email templates and auto messages that use one of the partials
+func (s *messagingServer) findPartialDependents(instanceID string, names map[string]bool) ([]partialDependent, error) {
+	emailTemplates, err := s.messageDBservice.FindAllEmailTempates(instanceID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	autoMessages, err := s.messageDBservice.FindAutoMessages(instanceID, false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dependents := []partialDependent{}
+	for _, t := range emailTemplates {
+		if !templates.UsesPartials(t, names) {
+			continue
+		}
+		name := "email template " + t.MessageType
+		if t.StudyKey != "" {
+			name += "@" + t.StudyKey
+		}
+		if t.IsDraft() {
+			name += " (draft)"
+		}
+		dependents = append(dependents, partialDependent{name: name, template: t})
+	}
+	for _, m := range autoMessages {
+		if !templates.UsesPartials(m.Template, names) {
+			continue
+		}
+		name := "auto message " + m.ID.Hex()
+		if m.Label != "" {
+			name += " (" + m.Label + ")"
+		}
+		dependents = append(dependents, partialDependent{name: name, template: m.Template})
+	}
+	return dependents, nil
+}
+
+func (s *messagingServer) GetTemplatePartials(ctx context.Context, req *api.GetTemplatePartialsReq) (*api.TemplatePartials, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetTemplatePartials, "permission denied")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.TemplatePartials{
+		Partials: make([]*api.TemplatePartial, len(partials)),
+	}
+	for i, v := range partials {
+		resp.Partials[i] = v.ToAPI()
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetTemplatePartials, "")
+	return resp, nil
+}
+
+func (s *messagingServer) SaveTemplatePartial(ctx context.Context, req *api.SaveTemplatePartialReq) (*api.TemplatePartial, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Partial == nil || req.Partial.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventSaveTemplatePartial, "permission denied for partial "+req.Partial.Name)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	partial := types.TemplatePartialFromAPI(req.Partial)
+	err := templates.CheckPartialParsable(partial)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the templates using the partial, directly or through other partials, have to resolve with the new version
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	replaced := false
+	for i, p := range partials {
+		if p.Name == partial.Name {
+			partials[i] = partial
+			replaced = true
+		}
+	}
+	if !replaced {
+		partials = append(partials, partial)
+	}
+	dependents, err := s.findPartialDependents(req.Token.InstanceId, templates.PartialsUsing(partials, partial.Name))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dependents {
+		if err := templates.CheckAllTranslationsParsable(req.Token.InstanceId, d.template, partials); err != nil {
+			return nil, status.Error(codes.InvalidArgument, d.name+": "+err.Error())
+		}
+		if err := templates.CheckTemplateVariables(req.Token.InstanceId, d.template, partials); err != nil {
+			return nil, status.Error(codes.InvalidArgument, d.name+": "+err.Error())
+		}
+	}
+
+	partial, err = s.messageDBservice.SaveTemplatePartial(req.Token.InstanceId, partial)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSaveTemplatePartial, partial.Name)
+	return partial.ToAPI(), nil
+}
+
+func (s *messagingServer) DeleteTemplatePartial(ctx context.Context, req *api.DeleteTemplatePartialReq) (*api.ServiceStatus, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventDeleteTemplatePartial, "permission denied for partial "+req.Name)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	partials, err := s.getTemplatePartials(req.Token.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	usedBy := []string{}
+	for name := range templates.PartialsUsing(partials, req.Name) {
+		if name != req.Name {
+			usedBy = append(usedBy, "partial "+name)
+		}
+	}
+	dependents, err := s.findPartialDependents(req.Token.InstanceId, map[string]bool{req.Name: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dependents {
+		usedBy = append(usedBy, d.name)
+	}
+	if len(usedBy) > 0 {
+		sort.Strings(usedBy)
+		return nil, status.Error(codes.FailedPrecondition, "partial is used by "+strings.Join(usedBy, ", "))
+	}
+
+	err = s.messageDBservice.DeleteTemplatePartial(req.Token.InstanceId, req.Name)
+	if err != nil {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_ERROR, logEventDeleteTemplatePartial, req.Name)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventDeleteTemplatePartial, req.Name)
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "partial deleted",
+	}, nil
+}
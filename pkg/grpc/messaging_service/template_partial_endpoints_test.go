@@ -0,0 +1,232 @@
+package messaging_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
+)
+
+func TestTemplatePartialEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+	researcherToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,RESEARCHER",
+			"username": "testuser",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "testuser",
+		},
+	}
+
+	t.Run("save without payload", func(t *testing.T) {
+		_, err := s.SaveTemplatePartial(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save without partial name", func(t *testing.T) {
+		_, err := s.SaveTemplatePartial(context.Background(), &api.SaveTemplatePartialReq{
+			Token:   researcherToken,
+			Partial: &api.TemplatePartial{},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save as participant", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveTemplatePartial(context.Background(), &api.SaveTemplatePartialReq{
+			Token:   participantToken,
+			Partial: &api.TemplatePartial{Name: "footer"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with unparsable partial", func(t *testing.T) {
+		_, err := s.SaveTemplatePartial(context.Background(), &api.SaveTemplatePartialReq{
+			Token: researcherToken,
+			Partial: &api.TemplatePartial{
+				Name: "footer",
+				Translations: []*api.LocalizedTemplate{
+					{Lang: "en", TemplateDef: "e3suZW5k"}, // {{.end
+				},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with reserved name", func(t *testing.T) {
+		_, err := s.SaveTemplatePartial(context.Background(), &api.SaveTemplatePartialReq{
+			Token: researcherToken,
+			Partial: &api.TemplatePartial{
+				Name: "content",
+				Translations: []*api.LocalizedTemplate{
+					{Lang: "en", TemplateDef: "dGVzdA=="},
+				},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid partial name `content`")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save valid partial", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.SaveTemplatePartial(context.Background(), &api.SaveTemplatePartialReq{
+			Token: researcherToken,
+			Partial: &api.TemplatePartial{
+				Name:            "footer",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					{Lang: "en", TemplateDef: "dGVzdA=="},
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Id == "" || resp.Name != "footer" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("get partials", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetTemplatePartials(context.Background(), &api.GetTemplatePartialsReq{
+			Token: researcherToken,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Partials) != 1 {
+			t.Errorf("unexpected number of partials: %d", len(resp.Partials))
+		}
+	})
+
+	t.Run("delete without name", func(t *testing.T) {
+		_, err := s.DeleteTemplatePartial(context.Background(), &api.DeleteTemplatePartialReq{
+			Token: researcherToken,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("delete not existing partial", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.DeleteTemplatePartial(context.Background(), &api.DeleteTemplatePartialReq{
+			Token: researcherToken,
+			Name:  "header",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	usingTemplate := types.EmailTemplate{
+		MessageType:     "partial-user",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: "e3t0ZW1wbGF0ZSAiZm9vdGVyIiAufX0="}, // {{template "footer" .}}
+		},
+	}
+	if _, err := testMessageDBService.SaveEmailTemplate(testInstanceID, usingTemplate); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	t.Run("save partial breaking a template using it", func(t *testing.T) {
+		_, err := s.SaveTemplatePartial(context.Background(), &api.SaveTemplatePartialReq{
+			Token: researcherToken,
+			Partial: &api.TemplatePartial{
+				Name:            "footer",
+				DefaultLanguage: "en",
+				Translations: []*api.LocalizedTemplate{
+					{Lang: "en", TemplateDef: "e3t0ZW1wbGF0ZSAibWlzc2luZyIgLn19"}, // {{template "missing" .}}
+				},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("delete partial used by a template", func(t *testing.T) {
+		_, err := s.DeleteTemplatePartial(context.Background(), &api.DeleteTemplatePartialReq{
+			Token: researcherToken,
+			Name:  "footer",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "partial is used by email template partial-user")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	if err := testMessageDBService.DeleteEmailTemplate(testInstanceID, usingTemplate.MessageType, ""); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	t.Run("delete partial", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.DeleteTemplatePartial(context.Background(), &api.DeleteTemplatePartialReq{
+			Token: researcherToken,
+			Name:  "footer",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package messaging_service
+
+import (
+	"context"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/go-utils/pkg/token_checks"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultWebhookDeliveriesLimit = 100
+
+func (s *messagingServer) GetWebhookTargets(ctx context.Context, req *api.GetWebhookTargetsReq) (*api.WebhookTargets, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_RESEARCHER, constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetWebhookTargets, "permission denied")
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	targets, err := s.messageDBservice.FindAllWebhookTargets(req.Token.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &api.WebhookTargets{
+		Targets: make([]*api.WebhookTarget, len(targets)),
+	}
+	for i, v := range targets {
+		resp.Targets[i] = v.ToAPI()
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetWebhookTargets, "")
+	return resp, nil
+}
+
+// SaveWebhookTarget adds or updates the target with the name, the current secret is kept if none is sent. Targets
+// receive the notifications of all studies they are not restricted from, so only admins can change them.
+func (s *messagingServer) SaveWebhookTarget(ctx context.Context, req *api.SaveWebhookTargetReq) (*api.WebhookTarget, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Target == nil || req.Target.Name == "" || req.Target.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventSaveWebhookTarget, "permission denied for webhook target "+req.Target.Name)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if err := types.CheckWebhookURL(req.Target.Url, s.allowHTTPWebhooks); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	target := types.WebhookTargetFromAPI(req.Target)
+	if target.Secret == "" {
+		existing, err := s.messageDBservice.FindWebhookTarget(req.Token.InstanceId, target.Name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "missing secret for new webhook target")
+		}
+		target.Secret = existing.Secret
+		target.CreatedAt = existing.CreatedAt
+	}
+
+	target, err := s.messageDBservice.SaveWebhookTarget(req.Token.InstanceId, target)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventSaveWebhookTarget, target.Name)
+	return target.ToAPI(), nil
+}
+
+func (s *messagingServer) DeleteWebhookTarget(ctx context.Context, req *api.DeleteWebhookTargetReq) (*api.ServiceStatus, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventDeleteWebhookTarget, "permission denied for webhook target "+req.Name)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	err := s.messageDBservice.DeleteWebhookTarget(req.Token.InstanceId, req.Name)
+	if err != nil {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_ERROR, logEventDeleteWebhookTarget, req.Name)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventDeleteWebhookTarget, req.Name)
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "webhook target deleted",
+	}, nil
+}
+
+// GetWebhookDeliveries returns the delivery log of a target for admins, the log contains the posted payloads
+func (s *messagingServer) GetWebhookDeliveries(ctx context.Context, req *api.GetWebhookDeliveriesReq) (*api.WebhookDeliveries, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !token_checks.CheckIfAnyRolesInToken(req.Token, []string{constants.USER_ROLE_ADMIN}) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventGetWebhookDeliveries, "permission denied for webhook deliveries "+req.TargetName)
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = defaultWebhookDeliveriesLimit
+	}
+	deliveries, err := s.messageDBservice.FindWebhookDeliveries(req.Token.InstanceId, req.TargetName, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventGetWebhookDeliveries, req.TargetName)
+	resp := &api.WebhookDeliveries{
+		Deliveries: make([]*api.WebhookDelivery, len(deliveries)),
+	}
+	for i, v := range deliveries {
+		resp.Deliveries[i] = v.ToAPI()
+	}
+	return resp, nil
+}
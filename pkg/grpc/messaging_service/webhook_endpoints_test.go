@@ -0,0 +1,259 @@
+package messaging_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	loggingMock "github.com/influenzanet/messaging-service/test/mocks/logging_service"
+)
+
+func TestWebhookEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := messagingServer{
+		messageDBservice: testMessageDBService,
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+	researcherToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,RESEARCHER",
+			"username": "testuser",
+		},
+	}
+	adminToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,ADMIN",
+			"username": "testuser",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "uid",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT",
+			"username": "testuser",
+		},
+	}
+
+	t.Run("save without target", func(t *testing.T) {
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token: adminToken,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save as participant", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  participantToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "https://example.com/hook", Secret: "secret"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save as researcher", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  researcherToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "https://example.com/hook", Secret: "secret"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with invalid url", func(t *testing.T) {
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  adminToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "ftp://example.com/hook", Secret: "secret"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid webhook url")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with http url", func(t *testing.T) {
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  adminToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "http://example.com/hook", Secret: "secret"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid webhook url")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save with internal url", func(t *testing.T) {
+		for _, u := range []string{"https://localhost/hook", "https://10.0.0.5/hook", "https://169.254.169.254/latest", "https://[::1]/hook"} {
+			_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+				Token:  adminToken,
+				Target: &api.WebhookTarget{Name: "redcap", Url: u, Secret: "secret"},
+			})
+			ok, msg := shouldHaveGrpcErrorStatus(err, "invalid webhook url host")
+			if !ok {
+				t.Errorf("%s: %s", u, msg)
+			}
+		}
+	})
+
+	t.Run("save new target without secret", func(t *testing.T) {
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  adminToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "https://example.com/hook"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing secret for new webhook target")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("save target", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  adminToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "https://example.com/hook", Secret: "secret"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Id == "" || resp.Secret != "" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("update target and keep secret", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.SaveWebhookTarget(context.Background(), &api.SaveWebhookTargetReq{
+			Token:  adminToken,
+			Target: &api.WebhookTarget{Name: "redcap", Url: "https://example.com/hook2"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		target, err := testMessageDBService.FindWebhookTarget(testInstanceID, "redcap")
+		if err != nil || target.URL != "https://example.com/hook2" || target.Secret != "secret" {
+			t.Errorf("unexpected target: %v, %v", target, err)
+		}
+	})
+
+	t.Run("get targets", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetWebhookTargets(context.Background(), &api.GetWebhookTargetsReq{
+			Token: researcherToken,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Targets) != 1 || resp.Targets[0].Secret != "" {
+			t.Errorf("unexpected targets: %v", resp.Targets)
+		}
+	})
+
+	t.Run("get deliveries", func(t *testing.T) {
+		if _, err := testMessageDBService.AddWebhookDelivery(testInstanceID, types.WebhookDelivery{
+			TargetName: "redcap",
+			Attempt:    1,
+			Delivered:  true,
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		resp, err := s.GetWebhookDeliveries(context.Background(), &api.GetWebhookDeliveriesReq{
+			Token:      adminToken,
+			TargetName: "redcap",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Deliveries) != 1 || !resp.Deliveries[0].Delivered {
+			t.Errorf("unexpected deliveries: %v", resp.Deliveries)
+		}
+	})
+
+	t.Run("get deliveries as researcher", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.GetWebhookDeliveries(context.Background(), &api.GetWebhookDeliveriesReq{
+			Token:      researcherToken,
+			TargetName: "redcap",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("delete target as researcher", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.DeleteWebhookTarget(context.Background(), &api.DeleteWebhookTargetReq{
+			Token: researcherToken,
+			Name:  "redcap",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("delete target", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err := s.DeleteWebhookTarget(context.Background(), &api.DeleteWebhookTargetReq{
+			Token: adminToken,
+			Name:  "redcap",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package push_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	defaultTTL     = 24 * 60 * 60 // push services drop notifications the browser did not fetch within a day
+)
+
+// ErrSubscriptionGone is returned if the push service does not know the subscription anymore, e.g. the user revoked
+// the permission. The subscription should be removed.
+var ErrSubscriptionGone = errors.New("push subscription expired or unsubscribed")
+
+// VAPIDConfig identifies the application server to the push services (RFC 8292)
+type VAPIDConfig struct {
+	PublicKey  string
+	PrivateKey string
+	Subject    string // contact email address of the operator
+	TTL        int    // seconds
+	Timeout    time.Duration
+}
+
+// PushClient sends web push notifications with the Web Push protocol (RFC 8030, payload encryption RFC 8291)
+type PushClient struct {
+	config     VAPIDConfig
+	httpClient *http.Client
+}
+
+func NewPushClient(config VAPIDConfig) *PushClient {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if config.TTL <= 0 {
+		config.TTL = defaultTTL
+	}
+	return &PushClient{
+		config:     config,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// SendPush encrypts the payload for the subscription and posts it to its endpoint. A response status 404 or 410 is
+// returned as ErrSubscriptionGone, any other status than 2xx is an error.
+func (c *PushClient) SendPush(ctx context.Context, sub types.PushSubscription, payload []byte) error {
+	resp, err := webpush.SendNotificationWithContext(ctx, payload, &webpush.Subscription{
+		Endpoint: sub.Endpoint,
+		Keys: webpush.Keys{
+			P256dh: sub.P256dh,
+			Auth:   sub.Auth,
+		},
+	}, &webpush.Options{
+		HTTPClient:      c.httpClient,
+		Subscriber:      c.config.Subject,
+		VAPIDPublicKey:  c.config.PublicKey,
+		VAPIDPrivateKey: c.config.PrivateKey,
+		TTL:             c.config.TTL,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionGone
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("push service responded with %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
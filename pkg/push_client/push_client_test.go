@@ -0,0 +1,190 @@
+package push_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"golang.org/x/crypto/hkdf"
+)
+
+// testBrowser holds the keys of a push subscription, to decrypt the notifications like a browser
+type testBrowser struct {
+	key    *ecdsa.PrivateKey
+	public []byte
+	auth   []byte
+}
+
+func newTestBrowser(t *testing.T) testBrowser {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatal(err)
+	}
+	return testBrowser{
+		key:    key,
+		public: elliptic.Marshal(elliptic.P256(), key.X, key.Y),
+		auth:   auth,
+	}
+}
+
+func (b testBrowser) subscription(endpoint string) types.PushSubscription {
+	return types.PushSubscription{
+		UserID:   "user1",
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.public),
+		Auth:     base64.RawURLEncoding.EncodeToString(b.auth),
+	}
+}
+
+// decrypt reads an aes128gcm encoded body (RFC 8188) with the keys derived as in RFC 8291
+func (b testBrowser) decrypt(body []byte) ([]byte, error) {
+	if len(body) < 21 {
+		return nil, errors.New("body too short")
+	}
+	salt := body[:16]
+	idLen := int(body[20])
+	serverPublic := body[21 : 21+idLen]
+	ciphertext := body[21+idLen:]
+
+	x, y := elliptic.Unmarshal(elliptic.P256(), serverPublic)
+	if x == nil {
+		return nil, errors.New("invalid server key")
+	}
+	sharedX, _ := elliptic.P256().ScalarMult(x, y, b.key.D.Bytes())
+	secret := make([]byte, 32)
+	sharedX.FillBytes(secret)
+
+	keyInfo := append(append([]byte("WebPush: info\x00"), b.public...), serverPublic...)
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, b.auth, keyInfo), ikm); err != nil {
+		return nil, err
+	}
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the last record ends with the delimiter 0x02, followed by padding
+	plain = bytes.TrimRight(plain, "\x00")
+	if len(plain) == 0 || plain[len(plain)-1] != 2 {
+		return nil, errors.New("missing padding delimiter")
+	}
+	return plain[:len(plain)-1], nil
+}
+
+func testVAPIDConfig(t *testing.T) VAPIDConfig {
+	privateKey, publicKey, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return VAPIDConfig{
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+		Subject:    "study@example.org",
+	}
+}
+
+func TestSendPush(t *testing.T) {
+	browser := newTestBrowser(t)
+	client := NewPushClient(testVAPIDConfig(t))
+
+	t.Run("with accepted notification", func(t *testing.T) {
+		var received []byte
+		var header http.Header
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			received, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer endpoint.Close()
+
+		err := client.SendPush(context.Background(), browser.subscription(endpoint.URL+"/push/1"), []byte(`{"title":"Reminder"}`))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if header.Get("Content-Encoding") != "aes128gcm" || header.Get("TTL") != "86400" {
+			t.Errorf("unexpected headers: %v", header)
+		}
+		if !strings.HasPrefix(header.Get("Authorization"), "vapid t=") {
+			t.Errorf("unexpected authorization header: %s", header.Get("Authorization"))
+		}
+		if binary.BigEndian.Uint32(received[16:20]) == 0 {
+			t.Error("unexpected record size")
+		}
+		payload, err := browser.decrypt(received)
+		if err != nil {
+			t.Errorf("notification could not be decrypted: %v", err)
+			return
+		}
+		if string(payload) != `{"title":"Reminder"}` {
+			t.Errorf("unexpected payload: %s", payload)
+		}
+	})
+
+	t.Run("with expired subscription", func(t *testing.T) {
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer endpoint.Close()
+
+		err := client.SendPush(context.Background(), browser.subscription(endpoint.URL), []byte("{}"))
+		if err != ErrSubscriptionGone {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with error response", func(t *testing.T) {
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		}))
+		defer endpoint.Close()
+
+		err := client.SendPush(context.Background(), browser.subscription(endpoint.URL), []byte("{}"))
+		if err == nil || err == ErrSubscriptionGone {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with invalid subscription keys", func(t *testing.T) {
+		err := client.SendPush(context.Background(), types.PushSubscription{Endpoint: "http://localhost", P256dh: "x", Auth: "y"}, []byte("{}"))
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
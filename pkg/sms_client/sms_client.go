@@ -0,0 +1,77 @@
+package sms_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// SMSProviderConfig configures the HTTP API of the SMS provider
+type SMSProviderConfig struct {
+	URL     string
+	APIKey  string
+	From    string // sender ID or number, the provider's default is used if empty
+	Timeout time.Duration
+}
+
+// SMSClient sends text messages through the HTTP API of an SMS provider
+type SMSClient struct {
+	config     SMSProviderConfig
+	httpClient *http.Client
+}
+
+type sendSMSReq struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+func NewSMSClient(config SMSProviderConfig) *SMSClient {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &SMSClient{
+		config:     config,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// SendSMS posts the message as JSON ({"from": ..., "to": ..., "text": ...}) to the provider URL, with the API key as
+// bearer token. Any response status other than 2xx is an error.
+func (c *SMSClient) SendSMS(ctx context.Context, to string, text string) error {
+	body, err := json.Marshal(sendSMSReq{
+		From: c.config.From,
+		To:   to,
+		Text: text,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("SMS provider responded with %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
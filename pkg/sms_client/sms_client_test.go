@@ -0,0 +1,52 @@
+package sms_client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSendSMS(t *testing.T) {
+	t.Run("with accepted message", func(t *testing.T) {
+		var received sendSMSReq
+		var auth string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		client := NewSMSClient(SMSProviderConfig{URL: server.URL, APIKey: "key", From: "Study"})
+		if err := client.SendSMS(context.Background(), "+4917012345678", "Reminder"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if auth != "Bearer key" {
+			t.Errorf("unexpected authorization header: %s", auth)
+		}
+		if received.From != "Study" || received.To != "+4917012345678" || received.Text != "Reminder" {
+			t.Errorf("unexpected request: %+v", received)
+		}
+	})
+
+	t.Run("with error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte("invalid number"))
+		}))
+		defer server.Close()
+
+		client := NewSMSClient(SMSProviderConfig{URL: server.URL})
+		err := client.SendSMS(context.Background(), "123", "Reminder")
+		if err == nil || !strings.Contains(err.Error(), "invalid number") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
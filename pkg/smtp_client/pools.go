@@ -0,0 +1,168 @@
+package smtp_client
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"gopkg.in/yaml.v2"
+)
+
+const DEFAULT_POOL = "default"
+
+// SmtpPoolsConfig names the server lists of the email client service and maps the emails to them: by the first
+// matching route, else by priority, else to the default pool
+type SmtpPoolsConfig struct {
+	Pools       map[string]string `yaml:"pools"` // name -> server list file, relative to the config file
+	Routes      []SmtpPoolRoute   `yaml:"routes"`
+	Priorities  map[string]string `yaml:"priorities"` // priority -> name of the pool
+	DefaultPool string            `yaml:"defaultPool"`
+}
+
+// SmtpPoolRoute sends the emails matching all of its non-empty fields through the pool, e.g. the emails of an
+// instance through its own relay
+type SmtpPoolRoute struct {
+	InstanceID  string `yaml:"instanceID"`
+	MessageType string `yaml:"messageType"`
+	Priority    string `yaml:"priority"`
+	Pool        string `yaml:"pool"`
+}
+
+func (r SmtpPoolRoute) matches(instanceID string, messageType string, priority string) bool {
+	return (r.InstanceID == "" || r.InstanceID == instanceID) &&
+		(r.MessageType == "" || r.MessageType == messageType) &&
+		(r.Priority == "" || r.Priority == priority)
+}
+
+// LegacySmtpPoolsConfig sends transactional and study-critical emails through the high prio servers and the others
+// through the default servers, as without pools config
+func LegacySmtpPoolsConfig(serverConfigPath string, highPrioServerConfigPath string) SmtpPoolsConfig {
+	return SmtpPoolsConfig{
+		Pools: map[string]string{
+			DEFAULT_POOL: serverConfigPath,
+			"high-prio":  highPrioServerConfigPath,
+		},
+		Priorities: map[string]string{
+			types.PRIORITY_TRANSACTIONAL:  "high-prio",
+			types.PRIORITY_STUDY_CRITICAL: "high-prio",
+		},
+		DefaultPool: DEFAULT_POOL,
+	}
+}
+
+// ReadFromFile reads and validates the config, the server list files are resolved relative to it
+func (c *SmtpPoolsConfig) ReadFromFile(fname string) error {
+	yamlFile, err := ioutil.ReadFile(fname)
+	if err != nil {
+		logger.Error.Printf("ReadPoolsConfig: err #%v ", err)
+		return err
+	}
+	if err := yaml.UnmarshalStrict(yamlFile, c); err != nil {
+		return err
+	}
+	for name, file := range c.Pools {
+		if !filepath.IsAbs(file) {
+			c.Pools[name] = filepath.Join(filepath.Dir(fname), file)
+		}
+	}
+	return c.Validate()
+}
+
+// Validate checks that the default pool and the pools of the priorities are defined
+func (c SmtpPoolsConfig) Validate() error {
+	if _, ok := c.Pools[c.DefaultPool]; !ok {
+		return errors.New("default pool `" + c.DefaultPool + "` is not defined")
+	}
+	for i, route := range c.Routes {
+		if route.Priority != "" && !types.IsPriority(route.Priority) {
+			return fmt.Errorf("route %d: unknown priority `%s`", i+1, route.Priority)
+		}
+		if _, ok := c.Pools[route.Pool]; !ok {
+			return fmt.Errorf("route %d: pool `%s` is not defined", i+1, route.Pool)
+		}
+	}
+	for priority, pool := range c.Priorities {
+		if !types.IsPriority(priority) {
+			return errors.New("unknown priority `" + priority + "`")
+		}
+		if _, ok := c.Pools[pool]; !ok {
+			return errors.New("pool `" + pool + "` of priority " + priority + " is not defined")
+		}
+	}
+	return nil
+}
+
+// PoolFor returns the name of the pool for the email, instance ID and message type are only used for the routes
+func (c SmtpPoolsConfig) PoolFor(instanceID string, messageType string, priority string) string {
+	for _, route := range c.Routes {
+		if route.matches(instanceID, messageType, priority) {
+			return route.Pool
+		}
+	}
+	if pool, ok := c.Priorities[priority]; ok {
+		return pool
+	}
+	return c.DefaultPool
+}
+
+// SmtpPools are the SMTP clients of all pools of a config. They are replaced as a whole when the config is reloaded,
+// sends started before keep using the old clients, which are closed when the last of these sends is done.
+type SmtpPools struct {
+	Config   SmtpPoolsConfig
+	Clients  map[string]*SmtpClients
+	inFlight sync.WaitGroup
+}
+
+// LoadSmtpPools reads the pools config, or uses the legacy config if the file does not exist, and connects the
+// servers of all pools. Without error, all server lists are valid.
+func LoadSmtpPools(poolsConfigPath string, serverConfigPath string, highPrioServerConfigPath string) (*SmtpPools, error) {
+	config := LegacySmtpPoolsConfig(serverConfigPath, highPrioServerConfigPath)
+	if _, err := os.Stat(poolsConfigPath); err == nil {
+		config = SmtpPoolsConfig{}
+		if err := config.ReadFromFile(poolsConfigPath); err != nil {
+			return nil, errors.New(poolsConfigPath + ": " + err.Error())
+		}
+	}
+
+	pools := &SmtpPools{
+		Config:  config,
+		Clients: map[string]*SmtpClients{},
+	}
+	for name, serverConfigPath := range config.Pools {
+		smtpClients, err := NewSmtpClients(serverConfigPath)
+		if err != nil {
+			pools.Close()
+			return nil, errors.New("pool " + name + ": " + err.Error())
+		}
+		pools.Clients[name] = smtpClients
+	}
+	return pools, nil
+}
+
+// Acquire marks a send as in flight, until Release is called
+func (p *SmtpPools) Acquire() {
+	p.inFlight.Add(1)
+}
+
+func (p *SmtpPools) Release() {
+	p.inFlight.Done()
+}
+
+// CloseWhenIdle closes the clients once all acquired sends are released
+func (p *SmtpPools) CloseWhenIdle() {
+	go func() {
+		p.inFlight.Wait()
+		p.Close()
+	}()
+}
+
+func (p *SmtpPools) Close() {
+	for _, smtpClients := range p.Clients {
+		smtpClients.Close()
+	}
+}
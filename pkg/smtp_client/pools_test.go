@@ -0,0 +1,132 @@
+package smtp_client
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestReadPoolsConfigFromFile(t *testing.T) {
+	t.Run("with wrong filename", func(t *testing.T) {
+		c := SmtpPoolsConfig{}
+		err := c.ReadFromFile("../../test/configs/nothere.yaml")
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("with undefined pool", func(t *testing.T) {
+		c := SmtpPoolsConfig{}
+		err := c.ReadFromFile("../../test/configs/smtp-pools-wrong.yaml")
+		if err == nil || err.Error() != "pool `transactional` of priority transactional is not defined" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with valid content", func(t *testing.T) {
+		c := SmtpPoolsConfig{}
+		err := c.ReadFromFile("../../test/configs/smtp-pools.yaml")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if c.Pools["bulk"] != "../../test/configs/smtp-servers.yaml" {
+			t.Errorf("unexpected server list file: %s", c.Pools["bulk"])
+		}
+		if c.PoolFor("instance", "weekly", types.PRIORITY_STUDY_CRITICAL) != "transactional" || c.PoolFor("instance", "weekly", types.PRIORITY_NEWSLETTER) != "bulk" {
+			t.Errorf("unexpected pools: %v", c.Priorities)
+		}
+	})
+}
+
+func TestSmtpPoolRoutes(t *testing.T) {
+	c := SmtpPoolsConfig{
+		Pools: map[string]string{
+			"default":     "smtp-servers.yaml",
+			"instance-a":  "instance-a-smtp-servers.yaml",
+			"newsletters": "newsletter-smtp-servers.yaml",
+		},
+		Routes: []SmtpPoolRoute{
+			{InstanceID: "instance-a", Priority: types.PRIORITY_NEWSLETTER, Pool: "newsletters"},
+			{InstanceID: "instance-a", Pool: "instance-a"},
+			{MessageType: "newsletter", Pool: "newsletters"},
+		},
+		DefaultPool: "default",
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	t.Run("route of the instance", func(t *testing.T) {
+		if pool := c.PoolFor("instance-a", "registration", types.PRIORITY_TRANSACTIONAL); pool != "instance-a" {
+			t.Errorf("unexpected pool: %s", pool)
+		}
+	})
+
+	t.Run("first matching route", func(t *testing.T) {
+		if pool := c.PoolFor("instance-a", "newsletter", types.PRIORITY_NEWSLETTER); pool != "newsletters" {
+			t.Errorf("unexpected pool: %s", pool)
+		}
+	})
+
+	t.Run("route of the message type", func(t *testing.T) {
+		if pool := c.PoolFor("instance-b", "newsletter", types.PRIORITY_NEWSLETTER); pool != "newsletters" {
+			t.Errorf("unexpected pool: %s", pool)
+		}
+	})
+
+	t.Run("without matching route", func(t *testing.T) {
+		if pool := c.PoolFor("instance-b", "weekly", types.PRIORITY_REMINDER); pool != "default" {
+			t.Errorf("unexpected pool: %s", pool)
+		}
+	})
+
+	t.Run("with undefined pool", func(t *testing.T) {
+		wrong := c
+		wrong.Routes = []SmtpPoolRoute{{InstanceID: "instance-c", Pool: "instance-c"}}
+		err := wrong.Validate()
+		if err == nil || err.Error() != "route 1: pool `instance-c` is not defined" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestLegacySmtpPoolsConfig(t *testing.T) {
+	c := LegacySmtpPoolsConfig("smtp-servers.yaml", "high-prio-smtp-servers.yaml")
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if c.PoolFor("instance", "registration", types.PRIORITY_TRANSACTIONAL) != "high-prio" || c.PoolFor("instance", "weekly", types.PRIORITY_REMINDER) != DEFAULT_POOL {
+		t.Errorf("unexpected pools: %v", c.Priorities)
+	}
+}
+
+func TestLoadSmtpPools(t *testing.T) {
+	t.Run("with invalid pools config", func(t *testing.T) {
+		_, err := LoadSmtpPools("../../test/configs/smtp-pools-wrong.yaml", "", "")
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("with invalid server list", func(t *testing.T) {
+		_, err := LoadSmtpPools("../../test/configs/nothere.yaml", "../../test/configs/smtp-servers-wrong.yaml", "../../test/configs/high-prio-smtp-servers.yaml")
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("with valid pools config", func(t *testing.T) {
+		pools, err := LoadSmtpPools("../../test/configs/smtp-pools.yaml", "", "")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		defer pools.Close()
+		if len(pools.Clients) != 2 || pools.Clients["bulk"] == nil {
+			t.Errorf("unexpected pools: %v", pools.Clients)
+		}
+	})
+}
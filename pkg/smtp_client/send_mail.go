@@ -1,7 +1,6 @@
 package smtp_client
 
 import (
-	"errors"
 	"net/textproto"
 	"time"
 
@@ -14,14 +13,16 @@ func (sc *SmtpClients) SendMail(
 	to []string,
 	subject string,
 	htmlContent string,
+	textContent string,
 	overrides *types.HeaderOverrides,
 ) error {
 	sc.counter += 1
 	if len(sc.connectionPool) < 1 {
-		sc.connectionPool = initConnectionPool(sc.servers)
-		if len(sc.connectionPool) < 1 {
-			return errors.New("no servers defined")
+		connectionPool, err := initConnectionPool(sc.servers)
+		if err != nil {
+			return err
 		}
+		sc.connectionPool = connectionPool
 	}
 
 	index := sc.counter % len(sc.connectionPool)
@@ -55,6 +56,10 @@ func (sc *SmtpClients) SendMail(
 		HTML:    []byte(htmlContent),
 		Headers: textproto.MIMEHeader{},
 	}
+	if textContent != "" {
+		// multipart/alternative with a plain-text part, e.g. for markdown templates
+		e.Text = []byte(textContent)
+	}
 	err := selectedServer.Send(e, time.Second*time.Duration(sc.servers.Servers[index].SendTimeout))
 
 	if err != nil {
@@ -2,6 +2,7 @@ package smtp_client
 
 import (
 	"crypto/tls"
+	"errors"
 	"net/smtp"
 
 	"github.com/coneno/logger"
@@ -20,15 +21,26 @@ func NewSmtpClients(configFile string) (*SmtpClients, error) {
 		return nil, err
 	}
 
+	connectionPool, err := initConnectionPool(serverList)
+	if err != nil {
+		return nil, err
+	}
 	sc := &SmtpClients{
 		servers:        serverList,
 		counter:        0,
-		connectionPool: initConnectionPool(serverList),
+		connectionPool: connectionPool,
 	}
 	return sc, nil
 }
 
-func initConnectionPool(serverList SmtpServerList) []email.Pool {
+// Close closes the connections of all servers
+func (sc *SmtpClients) Close() {
+	for i := range sc.connectionPool {
+		sc.connectionPool[i].Close()
+	}
+}
+
+func initConnectionPool(serverList SmtpServerList) ([]email.Pool, error) {
 	connectionPools := []email.Pool{}
 	for _, server := range serverList.Servers {
 		pool, err := connectToPool(server)
@@ -40,9 +52,9 @@ func initConnectionPool(serverList SmtpServerList) []email.Pool {
 		}
 	}
 	if len(connectionPools) < 1 {
-		logger.Error.Fatal("no smtp server connection in the pool")
+		return nil, errors.New("no smtp server connection in the pool")
 	}
-	return connectionPools
+	return connectionPools, nil
 }
 
 func connectToPool(server SmtpServer) (*email.Pool, error) {
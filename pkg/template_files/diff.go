@@ -0,0 +1,192 @@
+package template_files
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// DiffEmailTemplates describes the changes from prev to next line by line, including the changed lines of the
+// translations. It is empty if the templates are equal (ID, version and status are not compared).
+func DiffEmailTemplates(prev types.EmailTemplate, next types.EmailTemplate) []string {
+	diff := []string{}
+	changes := types.CompareEmailTemplates(prev, next)
+	if changes.DefaultLanguageChanged {
+		diff = append(diff, fmt.Sprintf("defaultLanguage: %q -> %q", prev.DefaultLanguage, next.DefaultLanguage))
+	}
+	if changes.LayoutChanged {
+		diff = append(diff, fmt.Sprintf("layout: %q -> %q", prev.Layout, next.Layout))
+	}
+	if changes.InlineCSSChanged {
+		diff = append(diff, fmt.Sprintf("inlineCss: %v -> %v", prev.InlineCSS, next.InlineCSS))
+	}
+	if changes.ChannelsChanged {
+		diff = append(diff, fmt.Sprintf("channels: %v -> %v", prev.Channels, next.Channels))
+	}
+	if changes.VariablesChanged && (len(prev.Variables) > 0 || len(next.Variables) > 0) {
+		diff = append(diff, fmt.Sprintf("variables: %v -> %v", prev.Variables, next.Variables))
+	}
+	if !headerOverridesEqual(derefHeaderOverrides(prev.HeaderOverrides), derefHeaderOverrides(next.HeaderOverrides)) {
+		diff = append(diff, fmt.Sprintf("headerOverrides: %+v -> %+v", derefHeaderOverrides(prev.HeaderOverrides), derefHeaderOverrides(next.HeaderOverrides)))
+	}
+
+	for _, lang := range changes.AddedLanguages {
+		diff = append(diff, "added translation "+lang)
+	}
+	for _, lang := range changes.RemovedLanguages {
+		diff = append(diff, "removed translation "+lang)
+	}
+	for _, lang := range changes.ChangedLanguages {
+		old, _ := findTranslation(prev.Translations, lang)
+		tr, _ := findTranslation(next.Translations, lang)
+		diff = append(diff, "changed translation "+lang)
+		if old.Subject != tr.Subject {
+			diff = append(diff, fmt.Sprintf("  subject: %q -> %q", old.Subject, tr.Subject))
+		}
+		if old.Format != tr.Format {
+			diff = append(diff, fmt.Sprintf("  format: %q -> %q", old.Format, tr.Format))
+		}
+		if old.TemplateDef != tr.TemplateDef {
+			for _, line := range DiffLines(decodeContent(old.TemplateDef), decodeContent(tr.TemplateDef)) {
+				diff = append(diff, "  "+line)
+			}
+		}
+		if old.SMSTemplate != tr.SMSTemplate {
+			diff = append(diff, "  sms:")
+			for _, line := range DiffLines(old.SMSTemplate, tr.SMSTemplate) {
+				diff = append(diff, "    "+line)
+			}
+		}
+		if old.PushTemplate != tr.PushTemplate {
+			diff = append(diff, "  push:")
+			for _, line := range DiffLines(old.PushTemplate, tr.PushTemplate) {
+				diff = append(diff, "    "+line)
+			}
+		}
+	}
+	return diff
+}
+
+// DiffAutoMessages describes the changes from prev to next, including the changes of the template
+func DiffAutoMessages(prev types.AutoMessage, next types.AutoMessage) []string {
+	diff := []string{}
+	if prev.Type != next.Type {
+		diff = append(diff, fmt.Sprintf("type: %q -> %q", prev.Type, next.Type))
+	}
+	if prev.StudyKey != next.StudyKey {
+		diff = append(diff, fmt.Sprintf("studyKey: %q -> %q", prev.StudyKey, next.StudyKey))
+	}
+	if prev.Label != next.Label {
+		diff = append(diff, fmt.Sprintf("label: %q -> %q", prev.Label, next.Label))
+	}
+	if !reflect.DeepEqual(prev.Condition, next.Condition) {
+		diff = append(diff, "condition changed")
+	}
+	if prev.NextTime != next.NextTime {
+		diff = append(diff, fmt.Sprintf("nextTime: %d -> %d", prev.NextTime, next.NextTime))
+	}
+	if prev.Period != next.Period {
+		diff = append(diff, fmt.Sprintf("period: %d -> %d", prev.Period, next.Period))
+	}
+	if prev.Until != next.Until {
+		diff = append(diff, fmt.Sprintf("until: %d -> %d", prev.Until, next.Until))
+	}
+	if prev.Template.MessageType != next.Template.MessageType {
+		diff = append(diff, fmt.Sprintf("template.messageType: %q -> %q", prev.Template.MessageType, next.Template.MessageType))
+	}
+	for _, line := range DiffEmailTemplates(prev.Template, next.Template) {
+		diff = append(diff, "template: "+line)
+	}
+	return diff
+}
+
+// DiffLines returns the removed ("- ") and added ("+ ") lines from a to b, based on their longest common
+// subsequence. Unchanged lines between changes are collapsed to "...".
+func DiffLines(a string, b string) []string {
+	linesA := strings.Split(a, "\n")
+	linesB := strings.Split(b, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of linesA[i:] and linesB[j:]
+	lcs := make([][]int, len(linesA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(linesB)+1)
+	}
+	for i := len(linesA) - 1; i >= 0; i-- {
+		for j := len(linesB) - 1; j >= 0; j-- {
+			if linesA[i] == linesB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	diff := []string{}
+	skipped := false
+	addUnchanged := func() {
+		if len(diff) > 0 {
+			skipped = true
+		}
+	}
+	addChange := func(line string) {
+		if skipped {
+			diff = append(diff, "...")
+			skipped = false
+		}
+		diff = append(diff, line)
+	}
+
+	i, j := 0, 0
+	for i < len(linesA) || j < len(linesB) {
+		switch {
+		case i < len(linesA) && j < len(linesB) && linesA[i] == linesB[j]:
+			addUnchanged()
+			i += 1
+			j += 1
+		case i < len(linesA) && (j == len(linesB) || lcs[i+1][j] >= lcs[i][j+1]):
+			addChange("- " + linesA[i])
+			i += 1
+		default:
+			addChange("+ " + linesB[j])
+			j += 1
+		}
+	}
+	return diff
+}
+
+func findTranslation(translations []types.LocalizedTemplate, lang string) (types.LocalizedTemplate, bool) {
+	for _, tr := range translations {
+		if tr.Lang == lang {
+			return tr, true
+		}
+	}
+	return types.LocalizedTemplate{}, false
+}
+
+func decodeContent(templateDef string) string {
+	content, err := base64.StdEncoding.DecodeString(templateDef)
+	if err != nil {
+		return templateDef
+	}
+	return string(content)
+}
+
+// headerOverridesEqual treats missing and empty header overrides and reply-to lists as equal
+func headerOverridesEqual(a types.HeaderOverrides, b types.HeaderOverrides) bool {
+	if len(a.ReplyTo) == 0 && len(b.ReplyTo) == 0 {
+		a.ReplyTo, b.ReplyTo = nil, nil
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func derefHeaderOverrides(h *types.HeaderOverrides) types.HeaderOverrides {
+	if h == nil {
+		return types.HeaderOverrides{}
+	}
+	return *h
+}
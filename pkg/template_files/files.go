@@ -0,0 +1,333 @@
+package template_files
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	EMAIL_TEMPLATES_DIR = "email-templates"
+	AUTO_MESSAGES_DIR   = "auto-messages"
+
+	TEMPLATE_FILE     = "template.yaml"
+	AUTO_MESSAGE_FILE = "auto-message.yaml"
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9@._-]+`)
+
+// TemplateFile is the YAML representation of an email template, the content of each translation is stored in its
+// own file next to it (e.g. en.html or en.md for markdown, en.sms.txt for the SMS and en.push.txt for the push variant)
+type TemplateFile struct {
+	MessageType     string                 `yaml:"messageType"`
+	StudyKey        string                 `yaml:"studyKey,omitempty"`
+	DefaultLanguage string                 `yaml:"defaultLanguage"`
+	Layout          string                 `yaml:"layout,omitempty"`
+	InlineCSS       bool                   `yaml:"inlineCss,omitempty"`
+	Channels        []string               `yaml:"channels,omitempty"`
+	Variables       []string               `yaml:"variables,omitempty"`
+	HeaderOverrides *types.HeaderOverrides `yaml:"headerOverrides,omitempty"`
+	Translations    []TranslationFile      `yaml:"translations"`
+}
+
+type TranslationFile struct {
+	Lang     string `yaml:"lang"`
+	Subject  string `yaml:"subject,omitempty"`
+	Format   string `yaml:"format,omitempty"`
+	File     string `yaml:"file"`
+	SMSFile  string `yaml:"smsFile,omitempty"`
+	PushFile string `yaml:"pushFile,omitempty"`
+}
+
+// AutoMessageFile is the YAML representation of an auto message, the ID links it to the auto message in the database.
+// NextTime is only used as start time of new auto messages, the schedule of existing ones is kept in the database.
+type AutoMessageFile struct {
+	ID        string               `yaml:"id,omitempty"`
+	Type      string               `yaml:"type"`
+	StudyKey  string               `yaml:"studyKey,omitempty"`
+	Label     string               `yaml:"label,omitempty"`
+	Condition *types.ExpressionArg `yaml:"condition,omitempty"`
+	NextTime  int64                `yaml:"nextTime,omitempty"`
+	Period    int64                `yaml:"period"`
+	Until     int64                `yaml:"until,omitempty"`
+	Template  TemplateFile         `yaml:"template"`
+}
+
+// EmailTemplateDirName is the directory of a template below email-templates, e.g. "weekly" or "weekly@study-key"
+func EmailTemplateDirName(t types.EmailTemplate) string {
+	name := t.MessageType
+	if t.StudyKey != "" {
+		name += "@" + t.StudyKey
+	}
+	return unsafeFilenameChars.ReplaceAllString(name, "_")
+}
+
+func translationFileName(tr types.LocalizedTemplate) string {
+	ext := ".html"
+	if tr.IsMarkdown() {
+		ext = ".md"
+	}
+	return unsafeFilenameChars.ReplaceAllString(tr.Lang, "_") + ext
+}
+
+func smsFileName(tr types.LocalizedTemplate) string {
+	return unsafeFilenameChars.ReplaceAllString(tr.Lang, "_") + ".sms.txt"
+}
+
+func pushFileName(tr types.LocalizedTemplate) string {
+	return unsafeFilenameChars.ReplaceAllString(tr.Lang, "_") + ".push.txt"
+}
+
+// WriteEmailTemplate writes the template file and the decoded translations into dir
+func WriteEmailTemplate(dir string, t types.EmailTemplate) error {
+	file, err := writeTemplateFiles(dir, t)
+	if err != nil {
+		return err
+	}
+	return writeYAML(filepath.Join(dir, TEMPLATE_FILE), file)
+}
+
+// ReadEmailTemplate reads a template written with WriteEmailTemplate
+func ReadEmailTemplate(dir string) (types.EmailTemplate, error) {
+	file := TemplateFile{}
+	if err := readYAML(filepath.Join(dir, TEMPLATE_FILE), &file); err != nil {
+		return types.EmailTemplate{}, err
+	}
+	return file.toEmailTemplate(dir)
+}
+
+// WriteAutoMessage writes the auto message file and the decoded translations of its template into dir
+func WriteAutoMessage(dir string, m types.AutoMessage) error {
+	templateFile, err := writeTemplateFiles(dir, m.Template)
+	if err != nil {
+		return err
+	}
+	file := AutoMessageFile{
+		ID:        m.ID.Hex(),
+		Type:      m.Type,
+		StudyKey:  m.StudyKey,
+		Label:     m.Label,
+		Condition: m.Condition,
+		Period:    m.Period,
+		Until:     m.Until,
+		Template:  templateFile,
+	}
+	if m.ID.IsZero() {
+		file.ID = ""
+		file.NextTime = m.NextTime
+	}
+	return writeYAML(filepath.Join(dir, AUTO_MESSAGE_FILE), file)
+}
+
+// ReadAutoMessage reads an auto message written with WriteAutoMessage, without ID it is a new auto message
+func ReadAutoMessage(dir string) (types.AutoMessage, error) {
+	file := AutoMessageFile{}
+	if err := readYAML(filepath.Join(dir, AUTO_MESSAGE_FILE), &file); err != nil {
+		return types.AutoMessage{}, err
+	}
+	m := types.AutoMessage{
+		Type:      file.Type,
+		StudyKey:  file.StudyKey,
+		Label:     file.Label,
+		Condition: file.Condition,
+		NextTime:  file.NextTime,
+		Period:    file.Period,
+		Until:     file.Until,
+	}
+	if file.ID != "" {
+		id, err := primitive.ObjectIDFromHex(file.ID)
+		if err != nil {
+			return m, errors.New(dir + ": invalid id `" + file.ID + "`")
+		}
+		m.ID = id
+	}
+	templ, err := file.Template.toEmailTemplate(dir)
+	if err != nil {
+		return m, err
+	}
+	m.Template = templ
+	return m, nil
+}
+
+// Export writes the templates and auto messages of an instance into dir, below email-templates and auto-messages
+func Export(dir string, emailTemplates []types.EmailTemplate, autoMessages []types.AutoMessage) error {
+	for _, t := range emailTemplates {
+		if err := WriteEmailTemplate(filepath.Join(dir, EMAIL_TEMPLATES_DIR, EmailTemplateDirName(t)), t); err != nil {
+			return err
+		}
+	}
+	for _, m := range autoMessages {
+		if err := WriteAutoMessage(filepath.Join(dir, AUTO_MESSAGES_DIR, m.ID.Hex()), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadAll reads the templates and auto messages from the subdirectories of dir, in the order of the directory names
+func ReadAll(dir string) (emailTemplates []types.EmailTemplate, autoMessages []types.AutoMessage, err error) {
+	emailTemplates = []types.EmailTemplate{}
+	autoMessages = []types.AutoMessage{}
+
+	templateDirs, err := subDirs(filepath.Join(dir, EMAIL_TEMPLATES_DIR))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, d := range templateDirs {
+		t, err := ReadEmailTemplate(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		emailTemplates = append(emailTemplates, t)
+	}
+
+	messageDirs, err := subDirs(filepath.Join(dir, AUTO_MESSAGES_DIR))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, d := range messageDirs {
+		m, err := ReadAutoMessage(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		autoMessages = append(autoMessages, m)
+	}
+	return emailTemplates, autoMessages, nil
+}
+
+func writeTemplateFiles(dir string, t types.EmailTemplate) (TemplateFile, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return TemplateFile{}, err
+	}
+	file := TemplateFile{
+		MessageType:     t.MessageType,
+		StudyKey:        t.StudyKey,
+		DefaultLanguage: t.DefaultLanguage,
+		Layout:          t.Layout,
+		InlineCSS:       t.InlineCSS,
+		Channels:        t.Channels,
+		Variables:       t.Variables,
+		HeaderOverrides: t.HeaderOverrides,
+		Translations:    make([]TranslationFile, len(t.Translations)),
+	}
+	for i, tr := range t.Translations {
+		content, err := base64.StdEncoding.DecodeString(tr.TemplateDef)
+		if err != nil {
+			return file, errors.New("translation `" + tr.Lang + "` of " + t.MessageType + " could not be decoded: " + err.Error())
+		}
+		name := translationFileName(tr)
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			return file, err
+		}
+		file.Translations[i] = TranslationFile{
+			Lang:    tr.Lang,
+			Subject: tr.Subject,
+			Format:  tr.Format,
+			File:    name,
+		}
+		if tr.SMSTemplate != "" {
+			smsName := smsFileName(tr)
+			if err := os.WriteFile(filepath.Join(dir, smsName), []byte(tr.SMSTemplate), 0644); err != nil {
+				return file, err
+			}
+			file.Translations[i].SMSFile = smsName
+		}
+		if tr.PushTemplate != "" {
+			pushName := pushFileName(tr)
+			if err := os.WriteFile(filepath.Join(dir, pushName), []byte(tr.PushTemplate), 0644); err != nil {
+				return file, err
+			}
+			file.Translations[i].PushFile = pushName
+		}
+	}
+	return file, nil
+}
+
+func (file TemplateFile) toEmailTemplate(dir string) (types.EmailTemplate, error) {
+	if file.MessageType == "" {
+		return types.EmailTemplate{}, errors.New(dir + ": messageType missing")
+	}
+	t := types.EmailTemplate{
+		MessageType:     file.MessageType,
+		StudyKey:        file.StudyKey,
+		DefaultLanguage: file.DefaultLanguage,
+		Layout:          file.Layout,
+		InlineCSS:       file.InlineCSS,
+		Channels:        file.Channels,
+		Variables:       file.Variables,
+		HeaderOverrides: file.HeaderOverrides,
+		Translations:    make([]types.LocalizedTemplate, len(file.Translations)),
+	}
+	for i, tr := range file.Translations {
+		// only files next to the template file can be referenced
+		content, err := os.ReadFile(filepath.Join(dir, filepath.Base(tr.File)))
+		if err != nil {
+			return t, err
+		}
+		t.Translations[i] = types.LocalizedTemplate{
+			Lang:        tr.Lang,
+			Subject:     tr.Subject,
+			Format:      tr.Format,
+			TemplateDef: base64.StdEncoding.EncodeToString(content),
+		}
+		if tr.SMSFile != "" {
+			smsContent, err := os.ReadFile(filepath.Join(dir, filepath.Base(tr.SMSFile)))
+			if err != nil {
+				return t, err
+			}
+			t.Translations[i].SMSTemplate = string(smsContent)
+		}
+		if tr.PushFile != "" {
+			pushContent, err := os.ReadFile(filepath.Join(dir, filepath.Base(tr.PushFile)))
+			if err != nil {
+				return t, err
+			}
+			t.Translations[i].PushTemplate = string(pushContent)
+		}
+	}
+	return t, nil
+}
+
+func subDirs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	dirs := []string{}
+	for _, e := range entries {
+		if e.IsDir() {
+			dirs = append(dirs, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+func writeYAML(filename string, value interface{}) error {
+	content, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, content, 0644)
+}
+
+func readYAML(filename string, value interface{}) error {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	if err := yaml.UnmarshalStrict(content, value); err != nil {
+		return errors.New(filename + ": " + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package template_files
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func encode(content string) string {
+	return base64.StdEncoding.EncodeToString([]byte(content))
+}
+
+func testTemplate() types.EmailTemplate {
+	return types.EmailTemplate{
+		MessageType:     "weekly",
+		StudyKey:        "study1",
+		DefaultLanguage: "en",
+		Layout:          "default",
+		InlineCSS:       true,
+		Channels:        []string{"push", "email"},
+		Variables:       []string{"surveys"},
+		HeaderOverrides: &types.HeaderOverrides{
+			From:    "study@example.com",
+			ReplyTo: []string{"reply@example.com"},
+		},
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", Subject: "Weekly survey", TemplateDef: encode("<p>Hello {{.name}}</p>\n"), SMSTemplate: "Hello {{.name}}\n", PushTemplate: "New survey for {{.name}}"},
+			{Lang: "de", Subject: "Wöchentliche Umfrage", Format: types.TEMPLATE_FORMAT_MARKDOWN, TemplateDef: encode("Hallo **{{.name}}**\n")},
+		},
+	}
+}
+
+func TestExportAndReadAll(t *testing.T) {
+	dir := t.TempDir()
+	templ := testTemplate()
+	message := types.AutoMessage{
+		ID:       primitive.NewObjectID(),
+		Type:     "all-users",
+		Label:    "newsletter",
+		NextTime: 1700000000,
+		Period:   86400,
+		Condition: &types.ExpressionArg{
+			DType: "exp",
+			Exp:   &types.Expression{Name: "checkEventType", Data: []types.ExpressionArg{{Str: "SUBMIT"}}},
+		},
+		Template: types.EmailTemplate{
+			MessageType:     "newsletter",
+			DefaultLanguage: "en",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", Subject: "News", TemplateDef: encode("<p>News</p>")},
+			},
+		},
+	}
+
+	err := Export(dir, []types.EmailTemplate{templ}, []types.AutoMessage{message})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, EMAIL_TEMPLATES_DIR, "weekly@study1", "de.md"))
+	if err != nil || string(content) != "Hallo **{{.name}}**\n" {
+		t.Errorf("unexpected translation file: %s, %v", content, err)
+	}
+
+	content, err = os.ReadFile(filepath.Join(dir, EMAIL_TEMPLATES_DIR, "weekly@study1", "en.sms.txt"))
+	if err != nil || string(content) != "Hello {{.name}}\n" {
+		t.Errorf("unexpected SMS file: %s, %v", content, err)
+	}
+
+	content, err = os.ReadFile(filepath.Join(dir, EMAIL_TEMPLATES_DIR, "weekly@study1", "en.push.txt"))
+	if err != nil || string(content) != "New survey for {{.name}}" {
+		t.Errorf("unexpected push file: %s, %v", content, err)
+	}
+
+	emailTemplates, autoMessages, err := ReadAll(dir)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(emailTemplates) != 1 || len(autoMessages) != 1 {
+		t.Errorf("unexpected number of results: %d, %d", len(emailTemplates), len(autoMessages))
+		return
+	}
+	if !reflect.DeepEqual(emailTemplates[0], templ) {
+		t.Errorf("unexpected template: %+v", emailTemplates[0])
+	}
+	message.NextTime = 0
+	if !reflect.DeepEqual(autoMessages[0], message) {
+		t.Errorf("unexpected auto message: %+v", autoMessages[0])
+	}
+}
+
+func TestWriteAutoMessage(t *testing.T) {
+	message := types.AutoMessage{
+		Type:     "all-users",
+		NextTime: 1700000000,
+		Period:   86400,
+		Template: types.EmailTemplate{MessageType: "newsletter", DefaultLanguage: "en"},
+	}
+
+	t.Run("new auto message keeps next time", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := WriteAutoMessage(dir, message); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		m, err := ReadAutoMessage(dir)
+		if err != nil || m.NextTime != message.NextTime {
+			t.Errorf("unexpected result: %+v, %v", m, err)
+		}
+	})
+
+	t.Run("existing auto message without next time", func(t *testing.T) {
+		dir := t.TempDir()
+		existing := message
+		existing.ID = primitive.NewObjectID()
+		if err := WriteAutoMessage(dir, existing); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		content, err := os.ReadFile(filepath.Join(dir, AUTO_MESSAGE_FILE))
+		if err != nil || strings.Contains(string(content), "nextTime") {
+			t.Errorf("unexpected file: %s, %v", content, err)
+		}
+	})
+}
+
+func TestReadAll(t *testing.T) {
+	t.Run("with empty directory", func(t *testing.T) {
+		emailTemplates, autoMessages, err := ReadAll(t.TempDir())
+		if err != nil || len(emailTemplates) != 0 || len(autoMessages) != 0 {
+			t.Errorf("unexpected result: %v, %v, %v", emailTemplates, autoMessages, err)
+		}
+	})
+
+	t.Run("with unknown field", func(t *testing.T) {
+		dir := t.TempDir()
+		templateDir := filepath.Join(dir, EMAIL_TEMPLATES_DIR, "weekly")
+		if err := os.MkdirAll(templateDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(templateDir, TEMPLATE_FILE), []byte("messageType: weekly\nlayuot: default\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, _, err := ReadAll(dir)
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("with missing translation file", func(t *testing.T) {
+		dir := t.TempDir()
+		templateDir := filepath.Join(dir, EMAIL_TEMPLATES_DIR, "weekly")
+		if err := os.MkdirAll(templateDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(templateDir, TEMPLATE_FILE), []byte("messageType: weekly\ntranslations:\n  - lang: en\n    file: en.html\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, _, err := ReadAll(dir)
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+}
+
+func TestDiffEmailTemplates(t *testing.T) {
+	t.Run("without changes", func(t *testing.T) {
+		next := testTemplate()
+		next.ID = primitive.NewObjectID()
+		next.Version = 3
+		if diff := DiffEmailTemplates(testTemplate(), next); len(diff) != 0 {
+			t.Errorf("unexpected diff: %v", diff)
+		}
+	})
+
+	t.Run("with changes", func(t *testing.T) {
+		next := testTemplate()
+		next.Layout = ""
+		next.Translations[0].TemplateDef = encode("<p>Hello {{.name}},</p>\n")
+		next.Translations = next.Translations[:1]
+		expected := []string{
+			`layout: "default" -> ""`,
+			"removed translation de",
+			"changed translation en",
+			"  - <p>Hello {{.name}}</p>",
+			"  + <p>Hello {{.name}},</p>",
+		}
+		if diff := DiffEmailTemplates(testTemplate(), next); !reflect.DeepEqual(diff, expected) {
+			t.Errorf("unexpected diff: %#v", diff)
+		}
+	})
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := DiffLines("a\nb\nc\nd\ne", "a\nB\nc\nd\ne\nf")
+	expected := []string{"- b", "+ B", "...", "+ f"}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("unexpected diff: %#v", diff)
+	}
+}
@@ -0,0 +1,313 @@
+package templates
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	cssCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	// pseudo-classes depending on the state of the element in the email client, and pseudo-elements
+	dynamicSelectorPattern = regexp.MustCompile(`(?i)::|:(hover|active|focus|focus-within|focus-visible|visited|link|target)\b`)
+)
+
+// inlineSpecificity ranks declarations of style attributes above any selector
+var inlineSpecificity = cascadia.Specificity{1 << 16, 0, 0}
+
+type cssDeclaration struct {
+	property  string
+	value     string
+	important bool
+}
+
+type cssRule struct {
+	selector     cascadia.Sel
+	specificity  cascadia.Specificity
+	order        int
+	declarations []cssDeclaration
+}
+
+// appliedDeclaration is a declaration matching an element, ordered by the cascade
+type appliedDeclaration struct {
+	cssDeclaration
+	specificity cascadia.Specificity
+	order       int
+}
+
+// InlineCSS moves the rules of <style> blocks into the style attributes of the matching elements, for email clients
+// that strip <style> blocks. Rules that cannot be inlined (at-rules like @media, pseudo-classes like :hover, or
+// selectors that cannot be parsed) are kept in the <style> block. Style elements with a media attribute are left as
+// they are, and content without <style> blocks is returned unchanged.
+func InlineCSS(content string) (string, error) {
+	if !strings.Contains(strings.ToLower(content), "<style") {
+		return content, nil
+	}
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+
+	rules := []cssRule{}
+	for _, styleNode := range findElements(doc, atom.Style) {
+		if _, ok := getAttribute(styleNode, "media"); ok {
+			continue
+		}
+		var css strings.Builder
+		for c := styleNode.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.TextNode {
+				css.WriteString(c.Data)
+			}
+		}
+		inlinable, kept := parseStylesheet(css.String(), len(rules))
+		rules = append(rules, inlinable...)
+
+		for styleNode.FirstChild != nil {
+			styleNode.RemoveChild(styleNode.FirstChild)
+		}
+		if kept == "" {
+			styleNode.Parent.RemoveChild(styleNode)
+		} else {
+			styleNode.AppendChild(&html.Node{Type: html.TextNode, Data: kept})
+		}
+	}
+	if len(rules) > 0 {
+		applyRules(doc, rules)
+	}
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// parseStylesheet splits the stylesheet into rules to inline and the CSS to keep in the <style> block
+func parseStylesheet(css string, firstOrder int) (inlinable []cssRule, kept string) {
+	css = cssCommentPattern.ReplaceAllString(css, "")
+	keptRules := []string{}
+	order := firstOrder
+
+	for {
+		css = strings.TrimSpace(css)
+		if css == "" {
+			break
+		}
+		open := strings.IndexByte(css, '{')
+		if open < 0 {
+			break
+		}
+		prelude := strings.TrimSpace(css[:open])
+		end := matchingBrace(css, open)
+		if end < 0 {
+			// unterminated block, keep it for the email client to deal with
+			keptRules = append(keptRules, css)
+			break
+		}
+		block := css[open+1 : end]
+		raw := css[:end+1]
+		css = css[end+1:]
+
+		if strings.HasPrefix(prelude, "@") {
+			keptRules = append(keptRules, strings.TrimSpace(raw))
+			continue
+		}
+
+		declarations := parseDeclarations(block)
+		notInlined := []string{}
+		for _, selector := range splitTopLevel(prelude, ',') {
+			if dynamicSelectorPattern.MatchString(selector) {
+				notInlined = append(notInlined, selector)
+				continue
+			}
+			sel, err := cascadia.Parse(selector)
+			if err != nil {
+				notInlined = append(notInlined, selector)
+				continue
+			}
+			inlinable = append(inlinable, cssRule{
+				selector:     sel,
+				specificity:  sel.Specificity(),
+				order:        order,
+				declarations: declarations,
+			})
+			order += 1
+		}
+		if len(notInlined) > 0 {
+			keptRules = append(keptRules, strings.Join(notInlined, ", ")+" {"+block+"}")
+		}
+	}
+	return inlinable, strings.Join(keptRules, "\n")
+}
+
+// matchingBrace returns the index of the brace closing the one at open, or -1
+func matchingBrace(css string, open int) int {
+	depth := 0
+	for i := open; i < len(css); i++ {
+		switch css[i] {
+		case '{':
+			depth += 1
+		case '}':
+			depth -= 1
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits at sep outside of parentheses and quotes, e.g. the selectors of a rule or the declarations
+// of a block with url(data:...;base64,...)
+func splitTopLevel(s string, sep byte) []string {
+	parts := []string{}
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth += 1
+		case c == ')':
+			depth -= 1
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, s[start:])
+
+	result := []string{}
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func parseDeclarations(block string) []cssDeclaration {
+	declarations := []cssDeclaration{}
+	for _, d := range splitTopLevel(block, ';') {
+		colon := strings.IndexByte(d, ':')
+		if colon < 0 {
+			continue
+		}
+		property := strings.ToLower(strings.TrimSpace(d[:colon]))
+		value := strings.TrimSpace(d[colon+1:])
+		important := false
+		if i := strings.Index(strings.ToLower(value), "!important"); i >= 0 {
+			important = true
+			value = strings.TrimSpace(value[:i])
+		}
+		if property == "" || value == "" {
+			continue
+		}
+		declarations = append(declarations, cssDeclaration{
+			property:  property,
+			value:     value,
+			important: important,
+		})
+	}
+	return declarations
+}
+
+func applyRules(doc *html.Node, rules []cssRule) {
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom != atom.Style && n.DataAtom != atom.Head && n.DataAtom != atom.Script {
+			applyToElement(n, rules)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+}
+
+func applyToElement(n *html.Node, rules []cssRule) {
+	applied := []appliedDeclaration{}
+	for _, rule := range rules {
+		if !rule.selector.Match(n) {
+			continue
+		}
+		for _, d := range rule.declarations {
+			applied = append(applied, appliedDeclaration{cssDeclaration: d, specificity: rule.specificity, order: rule.order})
+		}
+	}
+	if len(applied) == 0 {
+		return
+	}
+	style, _ := getAttribute(n, "style")
+	for _, d := range parseDeclarations(style) {
+		applied = append(applied, appliedDeclaration{cssDeclaration: d, specificity: inlineSpecificity, order: len(rules)})
+	}
+
+	// later declarations in this order win: importance, then specificity, then source order
+	sort.SliceStable(applied, func(i, j int) bool {
+		a, b := applied[i], applied[j]
+		if a.important != b.important {
+			return b.important
+		}
+		if a.specificity != b.specificity {
+			return a.specificity.Less(b.specificity)
+		}
+		return a.order < b.order
+	})
+	properties := []string{}
+	values := map[string]string{}
+	for _, d := range applied {
+		if _, ok := values[d.property]; !ok {
+			properties = append(properties, d.property)
+		}
+		values[d.property] = d.value
+	}
+
+	declarations := make([]string, len(properties))
+	for i, p := range properties {
+		declarations[i] = p + ": " + values[p]
+	}
+	setAttribute(n, "style", strings.Join(declarations, "; ")+";")
+}
+
+func findElements(n *html.Node, a atom.Atom) []*html.Node {
+	found := []*html.Node{}
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		found = append(found, n)
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		found = append(found, findElements(c, a)...)
+	}
+	return found
+}
+
+func getAttribute(n *html.Node, key string) (string, bool) {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+func setAttribute(n *html.Node, key string, value string) {
+	for i, attr := range n.Attr {
+		if attr.Key == key {
+			n.Attr[i].Val = value
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: value})
+}
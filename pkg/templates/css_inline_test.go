@@ -0,0 +1,71 @@
+package templates
+
+import (
+	"encoding/base64"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the tests")
+
+// TestInlineCSSGolden inlines testdata/css_inline/*.html and compares the result with the .golden files.
+// Run `go test ./pkg/templates -run TestInlineCSSGolden -update` after changing the inliner to update them.
+func TestInlineCSSGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "css_inline", "*.html"))
+	if err != nil || len(inputs) == 0 {
+		t.Fatalf("no test inputs found: %v", err)
+	}
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".html")
+		t.Run(name, func(t *testing.T) {
+			content, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := InlineCSS(string(content))
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			golden := strings.TrimSuffix(input, ".html") + ".golden"
+			if *updateGolden {
+				if err := os.WriteFile(golden, []byte(result), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != string(expected) {
+				t.Errorf("result does not match %s:\n%s", golden, result)
+			}
+		})
+	}
+}
+
+func TestInlineCSSWithTemplate(t *testing.T) {
+	email, err := ResolveTranslation(
+		"test",
+		types.EmailTemplate{InlineCSS: true},
+		types.LocalizedTemplate{
+			Lang:        "en",
+			TemplateDef: base64.StdEncoding.EncodeToString([]byte(`<style>.name { color: red; }</style><p class="name">{{.name}}</p>`)),
+		},
+		nil,
+		TemplateData{"name": "Tester"},
+	)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !strings.Contains(email.Content, `<p class="name" style="color: red;">Tester</p>`) || strings.Contains(email.Content, "<style>") {
+		t.Errorf("unexpected content: %s", email.Content)
+	}
+}
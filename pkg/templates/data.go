@@ -0,0 +1,90 @@
+package templates
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"text/template/parse"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PAYLOAD_JSON_PREFIX marks payload entries (e.g. of participant messages) with a JSON encoded value. The decoded
+// value is available under the key without the prefix, e.g. "json:surveys" as {{range .surveys}}.
+const PAYLOAD_JSON_PREFIX = "json:"
+
+// TemplateData is the value email templates are executed with. Flat content infos are strings, so existing templates
+// using {{.key}} or {{index . "key"}} keep working, while structured values can be lists and nested objects.
+type TemplateData map[string]interface{}
+
+// NewTemplateData copies the flat content infos, which can be nil
+func NewTemplateData(contentInfos map[string]string) TemplateData {
+	data := TemplateData{}
+	data.AddStrings(contentInfos)
+	return data
+}
+
+func (data TemplateData) AddStrings(values map[string]string) {
+	for k, v := range values {
+		data[k] = v
+	}
+}
+
+// AddPayload adds the payload entries as strings, and decodes the values of keys with PAYLOAD_JSON_PREFIX
+func (data TemplateData) AddPayload(payload map[string]string) error {
+	for k, v := range payload {
+		if !strings.HasPrefix(k, PAYLOAD_JSON_PREFIX) {
+			data[k] = v
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			return errors.New("payload `" + k + "` is not valid JSON: " + err.Error())
+		}
+		data[strings.TrimPrefix(k, PAYLOAD_JSON_PREFIX)] = value
+	}
+	return nil
+}
+
+// AddStruct adds the structured values of the API, e.g. content_data, which can be nil
+func (data TemplateData) AddStruct(values *structpb.Struct) {
+	for k, v := range values.AsMap() {
+		data[k] = v
+	}
+}
+
+// executionValue is the value the templates of trees are executed with. Without structured values it is a map of
+// strings as before, so that missing keys still resolve to "" (e.g. in {{if eq (index . "key") ""}} or {{len .key}})
+// instead of nil. With structured values, the keys the templates reference but the data is missing are set to "", as
+// they would render as "<no value>" otherwise.
+func (data TemplateData) executionValue(trees []*parse.Tree) interface{} {
+	flat := make(map[string]string, len(data))
+	for k, v := range data {
+		s, ok := v.(string)
+		if !ok {
+			return data.withEmptyKeys(referencedRootKeys(trees))
+		}
+		flat[k] = s
+	}
+	return flat
+}
+
+// withEmptyKeys returns a copy of the data with "" for the keys it is missing
+func (data TemplateData) withEmptyKeys(keys map[string]bool) map[string]interface{} {
+	value := make(map[string]interface{}, len(data)+len(keys))
+	for k := range keys {
+		value[k] = ""
+	}
+	for k, v := range data {
+		value[k] = v
+	}
+	return value
+}
+
+func (data TemplateData) Copy() TemplateData {
+	c := make(TemplateData, len(data))
+	for k, v := range data {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,147 @@
+package templates
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestTemplateDataPayload(t *testing.T) {
+	t.Run("flat payload", func(t *testing.T) {
+		data := NewTemplateData(map[string]string{"a": "1"})
+		err := data.AddPayload(map[string]string{"b": "[1, 2]"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if data["a"] != "1" || data["b"] != "[1, 2]" {
+			t.Errorf("unexpected data: %v", data)
+		}
+	})
+
+	t.Run("invalid JSON payload", func(t *testing.T) {
+		data := TemplateData{}
+		err := data.AddPayload(map[string]string{"json:surveys": "[{"})
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("JSON payload", func(t *testing.T) {
+		data := TemplateData{}
+		err := data.AddPayload(map[string]string{
+			"json:surveys": `[{"name": "weekly", "due": 1583191800}, {"name": "intake"}]`,
+			"json:profile": `{"alias": "Tester"}`,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		content, err := ResolveTemplate(
+			"test",
+			`{{.profile.alias}}:{{range .surveys}} {{.name}}{{with .due}} ({{formatDate . "2.1.2006"}}){{end}}{{end}}`,
+			nil,
+			data,
+		)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if content != "Tester: weekly (2.3.2020) intake" {
+			t.Errorf("unexpected content: %s", content)
+		}
+	})
+}
+
+func TestTemplateDataStruct(t *testing.T) {
+	values, err := structpb.NewStruct(map[string]interface{}{
+		"items": []interface{}{"a", "b"},
+		"name":  "structured",
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	data := NewTemplateData(map[string]string{"name": "flat", "other": "flat"})
+	data.AddStruct(values)
+	data.AddStruct(nil)
+
+	content, err := ResolveTemplate(
+		"test",
+		`{{index . "name"}} {{.other}} {{len .items}}{{range .items}} {{.}}{{end}}`,
+		nil,
+		data,
+	)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if content != "structured flat 2 a b" {
+		t.Errorf("unexpected content: %s", content)
+	}
+}
+
+func TestTemplateDataMissingKeys(t *testing.T) {
+	data := NewTemplateData(map[string]string{"a": "1"})
+
+	t.Run("with eq", func(t *testing.T) {
+		content, err := ResolveTemplate("missing-eq", `{{if eq (index . "k") ""}}empty{{else}}notempty{{end}}`, nil, data)
+		if err != nil || content != "empty" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	t.Run("with ne", func(t *testing.T) {
+		content, err := ResolveTemplate("missing-ne", `{{if ne (index . "k") ""}}notempty{{else}}empty{{end}}`, nil, data)
+		if err != nil || content != "empty" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	t.Run("with len", func(t *testing.T) {
+		content, err := ResolveTemplate("missing-len", `{{len (index . "k")}}`, nil, data)
+		if err != nil || content != "0" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	t.Run("with field", func(t *testing.T) {
+		content, err := ResolveTemplate("missing-field", `[{{.k}}]`, nil, data)
+		if err != nil || content != "[]" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	structured := NewTemplateData(map[string]string{"a": "1"})
+	structured["surveys"] = []interface{}{"s1", "s2"}
+
+	t.Run("with structured value and field", func(t *testing.T) {
+		content, err := ResolveTemplate("structured-missing-field", `[{{.k}}]{{range .surveys}}[{{$.other}}]{{end}}`, nil, structured)
+		if err != nil || content != "[][][]" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	t.Run("with structured value and eq", func(t *testing.T) {
+		content, err := ResolveTemplate("structured-missing-eq", `{{if eq (index . "k") ""}}empty{{else}}notempty{{end}} {{len .k}}`, nil, structured)
+		if err != nil || content != "empty 0" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	t.Run("with structured value in a partial", func(t *testing.T) {
+		shared := &SharedTemplates{Partials: map[string]string{"footer": `[{{.k}}]`}}
+		content, err := ResolveTemplate("structured-missing-partial", `{{template "footer" .}}`, shared, structured)
+		if err != nil || content != "[]" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+
+	t.Run("with structured value in a text variant", func(t *testing.T) {
+		content, err := executeTextTemplate("structured-missing-text", `[{{.k}}] {{len .surveys}}`, structured)
+		if err != nil || content != "[] 2" {
+			t.Errorf("unexpected result: %s, %v", content, err)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package templates
+
+import (
+	"strings"
+	"time"
+)
+
+type dateNames struct {
+	months      [12]string
+	monthsShort [12]string
+	days        [7]string // starting with Sunday, like time.Weekday
+	daysShort   [7]string
+}
+
+// localizedDateNames are the month and weekday names of the languages used by the instances, English is Go's default
+var localizedDateNames = map[string]dateNames{
+	"da": {
+		months:      [12]string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"},
+		monthsShort: [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		days:        [7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+		daysShort:   [7]string{"søn", "man", "tirs", "ons", "tors", "fre", "lør"},
+	},
+	"de": {
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		monthsShort: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		daysShort:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	"es": {
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		monthsShort: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		daysShort:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	"fr": {
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		monthsShort: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		daysShort:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	},
+	"it": {
+		months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		monthsShort: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		daysShort:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	},
+	"nl": {
+		months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		monthsShort: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		daysShort:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	},
+	"pt": {
+		months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		monthsShort: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		days:        [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		daysShort:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+}
+
+// formatLocalizedTime formats t like time.Format, with the month and weekday names of lang, e.g. "de" or "de-CH"
+func formatLocalizedTime(t time.Time, layout string, lang string) string {
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	names, ok := localizedDateNames[strings.ToLower(lang)]
+	if !ok {
+		return t.Format(layout)
+	}
+
+	var sb strings.Builder
+	segmentStart := 0
+	for i := 0; i < len(layout); {
+		var name string
+		var tokenLen int
+		switch {
+		case strings.HasPrefix(layout[i:], "January"):
+			name, tokenLen = names.months[t.Month()-1], len("January")
+		case strings.HasPrefix(layout[i:], "Jan"):
+			name, tokenLen = names.monthsShort[t.Month()-1], len("Jan")
+		case strings.HasPrefix(layout[i:], "Monday"):
+			name, tokenLen = names.days[t.Weekday()], len("Monday")
+		case strings.HasPrefix(layout[i:], "Mon"):
+			name, tokenLen = names.daysShort[t.Weekday()], len("Mon")
+		default:
+			i++
+			continue
+		}
+		sb.WriteString(t.Format(layout[segmentStart:i]))
+		sb.WriteString(name)
+		i += tokenLen
+		segmentStart = i
+	}
+	sb.WriteString(t.Format(layout[segmentStart:]))
+	return sb.String()
+}
@@ -0,0 +1,181 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// the service runs in a scratch image without zoneinfo
+	_ "time/tzdata"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// TemplateFuncs are the functions available in email templates and partials, see docs/email-templates.md.
+// Values are taken as interface{}, since a missing key of the content infos is passed as nil, and an empty or
+// missing value results in "" - templates are executed without content infos when they are checked on save.
+var TemplateFuncs = template.FuncMap{
+	"formatDate":   formatDate,
+	"formatNumber": formatNumber,
+	"plural":       pluralForm,
+	"buildURL":     buildURL,
+	"pathEscape":   pathEscape,
+	"default":      defaultValue,
+	"ifEq":         ifEq,
+	"eqIgnoreCase": eqIgnoreCase,
+	"oneOf":        oneOf,
+}
+
+func newTemplate(name string) *template.Template {
+	return template.New(name).Funcs(TemplateFuncs)
+}
+
+func stringValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		// numbers of structured data, e.g. timestamps, must not use the exponent format
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+func optionalArg(opts []interface{}, i int) string {
+	if len(opts) <= i {
+		return ""
+	}
+	return stringValue(opts[i])
+}
+
+// optionalLanguage is the language of the first optional argument, English if it is missing or empty
+func optionalLanguage(opts []interface{}) language.Tag {
+	if lang := optionalArg(opts, 0); lang != "" {
+		return language.Make(lang)
+	}
+	return language.English
+}
+
+// parseTimeValue accepts a Unix timestamp in seconds or an RFC 3339 date
+func parseTimeValue(value string) (time.Time, error) {
+	if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(ts, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.New("formatDate: invalid date `" + value + "`")
+	}
+	return t, nil
+}
+
+// formatDate formats a Unix timestamp or RFC 3339 date with a Go layout, e.g. "Monday, 2 January 2006 15:04".
+// Optional arguments are the language of the month and weekday names, and the timezone (default UTC).
+func formatDate(v interface{}, layout string, opts ...interface{}) (string, error) {
+	value := stringValue(v)
+	if value == "" {
+		return "", nil
+	}
+	t, err := parseTimeValue(value)
+	if err != nil {
+		return "", err
+	}
+	loc := time.UTC
+	if tz := optionalArg(opts, 1); tz != "" {
+		loc, err = time.LoadLocation(tz)
+		if err != nil {
+			return "", errors.New("formatDate: unknown timezone `" + tz + "`")
+		}
+	}
+	return formatLocalizedTime(t.In(loc), layout, optionalArg(opts, 0)), nil
+}
+
+// formatNumber formats a number with the given decimals and the digit grouping of the language (optional)
+func formatNumber(v interface{}, decimals int, opts ...interface{}) (string, error) {
+	value := stringValue(v)
+	if value == "" {
+		return "", nil
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", errors.New("formatNumber: invalid number `" + value + "`")
+	}
+	return message.NewPrinter(optionalLanguage(opts)).Sprintf("%.*f", decimals, n), nil
+}
+
+// pluralForm returns one or other, depending on the plural rules of the language (optional, default English).
+// Counts that are not integers use the other form.
+func pluralForm(count interface{}, one interface{}, other interface{}, opts ...interface{}) string {
+	n, err := strconv.ParseFloat(stringValue(count), 64)
+	if err != nil || n != math.Trunc(n) {
+		return stringValue(other)
+	}
+	i := int(math.Abs(n))
+	if plural.Cardinal.MatchPlural(optionalLanguage(opts), i, 0, 0, 0, 0) == plural.One {
+		return stringValue(one)
+	}
+	return stringValue(other)
+}
+
+// buildURL appends the key value pairs as query parameters to base, escaping the values
+func buildURL(base interface{}, keyValues ...interface{}) (string, error) {
+	if len(keyValues)%2 != 0 {
+		return "", errors.New("buildURL: expected key value pairs")
+	}
+	var sb strings.Builder
+	sb.WriteString(stringValue(base))
+	sep := "?"
+	if strings.Contains(sb.String(), "?") {
+		sep = "&"
+	}
+	for i := 0; i < len(keyValues); i += 2 {
+		sb.WriteString(sep)
+		sb.WriteString(url.QueryEscape(stringValue(keyValues[i])))
+		sb.WriteString("=")
+		sb.WriteString(url.QueryEscape(stringValue(keyValues[i+1])))
+		sep = "&"
+	}
+	return sb.String(), nil
+}
+
+// defaultValue takes the fallback first, so it can be used at the end of a pipeline: {{.name | default "there"}}
+func defaultValue(fallback interface{}, v interface{}) string {
+	if value := stringValue(v); value != "" {
+		return value
+	}
+	return stringValue(fallback)
+}
+
+func pathEscape(v interface{}) string {
+	return url.PathEscape(stringValue(v))
+}
+
+func ifEq(v interface{}, compare interface{}, then interface{}, otherwise interface{}) string {
+	if stringValue(v) == stringValue(compare) {
+		return stringValue(then)
+	}
+	return stringValue(otherwise)
+}
+
+func eqIgnoreCase(v interface{}, compare interface{}) bool {
+	return strings.EqualFold(stringValue(v), stringValue(compare))
+}
+
+func oneOf(v interface{}, options ...interface{}) bool {
+	value := stringValue(v)
+	for _, o := range options {
+		if value == stringValue(o) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package templates
+
+import (
+	"testing"
+)
+
+func TestFormatDate(t *testing.T) {
+	// Monday, 2 March 2020 23:30:00 UTC
+	ts := "1583191800"
+
+	t.Run("empty value", func(t *testing.T) {
+		v, err := formatDate("", "2006-01-02")
+		if err != nil || v != "" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := formatDate("yesterday", "2006-01-02")
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("unix timestamp without language", func(t *testing.T) {
+		v, err := formatDate(ts, "Monday, 2 January 2006 15:04")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if v != "Monday, 2 March 2020 23:30" {
+			t.Errorf("unexpected result: %s", v)
+		}
+	})
+
+	t.Run("RFC 3339 date", func(t *testing.T) {
+		v, err := formatDate("2020-03-02T23:30:00Z", "02.01.2006")
+		if err != nil || v != "02.03.2020" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+
+	t.Run("with language", func(t *testing.T) {
+		v, err := formatDate(ts, "Monday, 2. January 2006 (Mon, Jan)", "de")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if v != "Montag, 2. März 2020 (Mo, Mär)" {
+			t.Errorf("unexpected result: %s", v)
+		}
+	})
+
+	t.Run("with regional language and timezone", func(t *testing.T) {
+		v, err := formatDate(ts, "Monday 2 January 15:04", "fr-CH", "Europe/Zurich")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if v != "mardi 3 mars 00:30" {
+			t.Errorf("unexpected result: %s", v)
+		}
+	})
+
+	t.Run("unknown language", func(t *testing.T) {
+		v, err := formatDate(ts, "January", "xx")
+		if err != nil || v != "March" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+
+	t.Run("unknown timezone", func(t *testing.T) {
+		_, err := formatDate(ts, "January", "en", "Mars/Olympus")
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Run("empty value", func(t *testing.T) {
+		v, err := formatNumber("", 2)
+		if err != nil || v != "" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := formatNumber("many", 2)
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("default language", func(t *testing.T) {
+		v, err := formatNumber("1234567.891", 2)
+		if err != nil || v != "1,234,567.89" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+
+	t.Run("with language", func(t *testing.T) {
+		v, err := formatNumber("1234567.891", 1, "de")
+		if err != nil || v != "1.234.567,9" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+}
+
+func TestPluralForm(t *testing.T) {
+	for _, c := range []struct {
+		count string
+		lang  string
+		want  string
+	}{
+		{"1", "", "one"},
+		{"2", "", "other"},
+		{"0", "en", "other"},
+		{"0", "fr", "one"},
+		{"-1", "de", "one"},
+		{"1.5", "en", "other"},
+		{"", "en", "other"},
+	} {
+		if v := pluralForm(c.count, "one", "other", c.lang); v != c.want {
+			t.Errorf("unexpected form for %s (%s): %s", c.count, c.lang, v)
+		}
+	}
+}
+
+func TestBuildURL(t *testing.T) {
+	t.Run("odd number of arguments", func(t *testing.T) {
+		_, err := buildURL("https://example.com", "token")
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("escaped values", func(t *testing.T) {
+		v, err := buildURL("https://example.com/login", "token", "a+b/c", "lang", "de")
+		if err != nil || v != "https://example.com/login?token=a%2Bb%2Fc&lang=de" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+
+	t.Run("base with query", func(t *testing.T) {
+		v, err := buildURL("https://example.com/?a=1", "b", "2 3")
+		if err != nil || v != "https://example.com/?a=1&b=2+3" {
+			t.Errorf("unexpected result: %s, %v", v, err)
+		}
+	})
+}
+
+func TestTemplateFuncsInTemplates(t *testing.T) {
+	contentInfos := TemplateData{
+		"language":  "de",
+		"name":      "",
+		"count":     "3",
+		"studyKey":  "study one",
+		"reportDay": "1583191800",
+	}
+	for _, c := range []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"default", `Hallo {{.name | default "Teilnehmer"}}`, "Hallo Teilnehmer"},
+		{"ifEq", `{{ifEq .language "de" "Hallo" "Hello"}}`, "Hallo"},
+		{"eqIgnoreCase", `{{if eqIgnoreCase .language "DE"}}ok{{end}}`, "ok"},
+		{"oneOf", `{{if oneOf .language "fr" "de"}}ok{{end}}`, "ok"},
+		{"plural", `{{.count}} {{plural .count "Umfrage" "Umfragen" .language}}`, "3 Umfragen"},
+		{"formatDate", `{{formatDate .reportDay "2. January" .language}}`, "2. März"},
+		{"formatNumber", `{{formatNumber "1500" 0 .language}}`, "1.500"},
+		{"buildURL", `<a href="{{buildURL "https://example.com/study" "key" .studyKey}}">link</a>`, `<a href="https://example.com/study?key=study&#43;one">link</a>`},
+		{"pathEscape", `<a href="https://example.com/{{pathEscape .studyKey}}">link</a>`, `<a href="https://example.com/study%20one">link</a>`},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			content, err := ResolveTemplate(c.name, c.template, nil, contentInfos)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if content != c.want {
+				t.Errorf("unexpected content: %s", content)
+			}
+		})
+	}
+
+	t.Run("empty values when checking templates", func(t *testing.T) {
+		_, err := ResolveTemplate("test", `{{formatDate .reportDay "2006" .language .timezone}}{{formatNumber .count 2 .language}}{{plural .count "a" "b" .language}}{{ifEq .language "de" "a" "b"}}`, nil, TemplateData{})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
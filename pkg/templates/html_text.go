@@ -0,0 +1,159 @@
+package templates
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	whitespacePattern = regexp.MustCompile(`[ \t\r\n]+`)
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+)
+
+var blockElements = map[string]bool{
+	"p": true, "div": true, "table": true, "tr": true, "blockquote": true, "pre": true,
+	"ul": true, "ol": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// HTMLToText converts rendered HTML into the plain-text part of an email: block elements are separated by
+// blank lines, list items start with "- " or their number, and links are followed by their URL.
+func HTMLToText(content string) string {
+	var sb strings.Builder
+	z := html.NewTokenizer(strings.NewReader(content))
+
+	skipDepth := 0
+	inPre := false
+	listCounters := []int{} // -1 for unordered lists
+	linkHrefs := []string{}
+	linkTextStart := []int{}
+
+	newline := func(count int) {
+		text := strings.TrimRight(sb.String(), " ")
+		if text == "" {
+			return
+		}
+		existing := len(text) - len(strings.TrimRight(text, "\n"))
+		sb.Reset()
+		sb.WriteString(text)
+		for i := existing; i < count; i++ {
+			sb.WriteString("\n")
+		}
+	}
+
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			// io.EOF, or invalid HTML that cannot be tokenized further
+			break
+		}
+		token := z.Token()
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.Data {
+			case "style", "script", "head", "title":
+				if tt == html.StartTagToken {
+					skipDepth += 1
+				}
+			case "br":
+				sb.WriteString("\n")
+			case "hr":
+				newline(2)
+				sb.WriteString("---")
+				newline(2)
+			case "ul":
+				newline(2)
+				listCounters = append(listCounters, -1)
+			case "ol":
+				newline(2)
+				listCounters = append(listCounters, 0)
+			case "li":
+				newline(1)
+				if n := len(listCounters); n > 0 && listCounters[n-1] >= 0 {
+					listCounters[n-1] += 1
+					sb.WriteString(strconv.Itoa(listCounters[n-1]) + ". ")
+				} else {
+					sb.WriteString("- ")
+				}
+			case "a":
+				href := ""
+				for _, attr := range token.Attr {
+					if attr.Key == "href" {
+						href = attr.Val
+					}
+				}
+				linkHrefs = append(linkHrefs, href)
+				linkTextStart = append(linkTextStart, sb.Len())
+			case "img":
+				for _, attr := range token.Attr {
+					if attr.Key == "alt" {
+						sb.WriteString(attr.Val)
+					}
+				}
+			case "pre":
+				newline(2)
+				inPre = true
+			default:
+				if blockElements[token.Data] {
+					newline(2)
+				}
+			}
+		case html.EndTagToken:
+			switch token.Data {
+			case "style", "script", "head", "title":
+				if skipDepth > 0 {
+					skipDepth -= 1
+				}
+			case "ul", "ol":
+				if len(listCounters) > 0 {
+					listCounters = listCounters[:len(listCounters)-1]
+				}
+				newline(2)
+			case "a":
+				if n := len(linkHrefs); n > 0 {
+					href := linkHrefs[n-1]
+					text := ""
+					if start := linkTextStart[n-1]; start < sb.Len() {
+						text = strings.TrimSpace(sb.String()[start:])
+					}
+					if href != "" && href != text && !strings.HasPrefix(href, "#") {
+						sb.WriteString(" (" + href + ")")
+					}
+					linkHrefs = linkHrefs[:n-1]
+					linkTextStart = linkTextStart[:n-1]
+				}
+			case "pre":
+				inPre = false
+				newline(2)
+			case "td", "th":
+				sb.WriteString(" ")
+			default:
+				if blockElements[token.Data] {
+					newline(2)
+				}
+			}
+		case html.TextToken:
+			if skipDepth > 0 {
+				continue
+			}
+			if inPre {
+				sb.WriteString(token.Data)
+				continue
+			}
+			text := whitespacePattern.ReplaceAllString(token.Data, " ")
+			current := sb.String()
+			if current == "" || strings.HasSuffix(current, "\n") || strings.HasSuffix(current, " ") {
+				text = strings.TrimLeft(text, " ")
+			}
+			sb.WriteString(text)
+		}
+	}
+
+	lines := strings.Split(sb.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimSpace(blankLinesPattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
@@ -0,0 +1,127 @@
+package templates
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"golang.org/x/text/language"
+)
+
+const (
+	ENV_LANGUAGE_FALLBACKS_JSON = "LANGUAGE_FALLBACKS_JSON"
+)
+
+// LanguageFallbacks are the languages to try per requested language (e.g. "lb": ["fr", "de"]), before the
+// closest translation is matched and the default language is used
+type LanguageFallbacks map[string][]string
+
+var (
+	languageFallbacks     map[string]LanguageFallbacks
+	languageFallbacksOnce sync.Once
+)
+
+// LoadLanguageFallbacks reads the fallback chains per instance ID from the file configured with
+// LANGUAGE_FALLBACKS_JSON, e.g. {"my-instance": {"lb": ["fr", "de"]}}. The file is read once.
+func LoadLanguageFallbacks() map[string]LanguageFallbacks {
+	languageFallbacksOnce.Do(func() {
+		filename := os.Getenv(ENV_LANGUAGE_FALLBACKS_JSON)
+		if filename == "" {
+			return
+		}
+		file, err := os.Open(filename)
+		if err != nil {
+			logger.Error.Printf("Error loading language fallbacks config file: %v", err)
+			return
+		}
+		defer file.Close()
+
+		var config map[string]LanguageFallbacks
+		if err := json.NewDecoder(file).Decode(&config); err != nil {
+			logger.Error.Printf("Error parsing language fallbacks config file: %v", err)
+			return
+		}
+		languageFallbacks = config
+	})
+	return languageFallbacks
+}
+
+// chainFor returns the configured chain for lang, or for its base language, e.g. "de" for "de-CH"
+func (f LanguageFallbacks) chainFor(lang string) []string {
+	if chain, ok := f[lang]; ok {
+		return chain
+	}
+	base, _ := language.Make(lang).Base()
+	return f[base.String()]
+}
+
+// selectTranslation tries an exact match, the fallback chain, and the closest translation (e.g. "de" for "de-CH")
+// by BCP 47 matching. If none is found, the default translation is returned with usedDefault set.
+func selectTranslation(
+	defaultLanguage string,
+	translations []types.LocalizedTemplate,
+	lang string,
+	fallbacks LanguageFallbacks,
+) (translation types.LocalizedTemplate, usedDefault bool) {
+	if tr, ok := findTranslation(translations, lang); ok {
+		return tr, false
+	}
+	if lang != "" {
+		for _, fallback := range fallbacks.chainFor(lang) {
+			if tr, ok := findTranslation(translations, fallback); ok {
+				return tr, false
+			}
+		}
+		if tr, ok := matchTranslation(translations, lang); ok {
+			return tr, false
+		}
+	}
+	tr, _ := findTranslation(translations, defaultLanguage)
+	return tr, true
+}
+
+func findTranslation(translations []types.LocalizedTemplate, lang string) (types.LocalizedTemplate, bool) {
+	for _, tr := range translations {
+		if strings.EqualFold(tr.Lang, lang) {
+			return tr, true
+		}
+	}
+	return types.LocalizedTemplate{}, false
+}
+
+func matchTranslation(translations []types.LocalizedTemplate, lang string) (types.LocalizedTemplate, bool) {
+	requested, err := language.Parse(lang)
+	if err != nil {
+		return types.LocalizedTemplate{}, false
+	}
+	// the first supported tag is what the matcher returns without a match, so it must not be a translation
+	supported := []language.Tag{language.Und}
+	for _, tr := range translations {
+		supported = append(supported, language.Make(tr.Lang))
+	}
+	_, index, confidence := language.NewMatcher(supported).Match(requested)
+	if index == 0 || confidence < language.High {
+		return types.LocalizedTemplate{}, false
+	}
+	return translations[index-1], true
+}
+
+// MatchTemplateTranslation selects the translation of tDef for lang with the fallback chains of the instance.
+// If the default language has to be used instead of a requested language, a warning is logged and usedDefault is set
+// (not if no language is requested, e.g. for researcher notifications).
+func MatchTemplateTranslation(instanceID string, tDef types.EmailTemplate, lang string) (translation types.LocalizedTemplate, usedDefault bool) {
+	translation, usedDefault = SelectTemplateTranslation(instanceID, tDef, lang)
+	if usedDefault {
+		logger.Warning.Printf("no translation of template '%s' for language '%s', using default language '%s' [%s:%s]", tDef.MessageType, lang, tDef.DefaultLanguage, instanceID, tDef.StudyKey)
+	}
+	return translation, usedDefault
+}
+
+// SelectTemplateTranslation is MatchTemplateTranslation without the warning, e.g. for previews and bulk messages
+func SelectTemplateTranslation(instanceID string, tDef types.EmailTemplate, lang string) (translation types.LocalizedTemplate, usedDefault bool) {
+	translation, usedDefault = selectTranslation(tDef.DefaultLanguage, tDef.Translations, lang, LoadLanguageFallbacks()[instanceID])
+	return translation, usedDefault && lang != ""
+}
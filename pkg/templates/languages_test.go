@@ -0,0 +1,78 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestSelectTranslation(t *testing.T) {
+	translations := []types.LocalizedTemplate{
+		{Lang: "en", Subject: "EN"},
+		{Lang: "de", Subject: "DE"},
+		{Lang: "fr", Subject: "FR"},
+		{Lang: "pt-BR", Subject: "PT-BR"},
+	}
+	fallbacks := LanguageFallbacks{
+		"lb":    {"fr", "de"},
+		"rm":    {"it", "de"},
+		"de-AT": {"fr"},
+	}
+
+	for _, c := range []struct {
+		lang        string
+		subject     string
+		usedDefault bool
+	}{
+		{"de", "DE", false},
+		{"DE", "DE", false},
+		{"de-CH", "DE", false},
+		{"de_CH", "DE", false},
+		{"en-GB", "EN", false},
+		{"pt", "PT-BR", false},
+		{"lb", "FR", false},
+		{"lb-LU", "FR", false},
+		{"rm", "DE", false},
+		{"de-AT", "FR", false},
+		{"it", "EN", true},
+		{"not a language", "EN", true},
+		{"", "EN", true},
+	} {
+		tr, usedDefault := selectTranslation("en", translations, c.lang, fallbacks)
+		if tr.Subject != c.subject || usedDefault != c.usedDefault {
+			t.Errorf("unexpected translation for '%s': %s (default: %v)", c.lang, tr.Subject, usedDefault)
+		}
+	}
+
+	t.Run("without fallbacks", func(t *testing.T) {
+		tr, usedDefault := selectTranslation("de", translations, "lb", nil)
+		if tr.Subject != "DE" || usedDefault {
+			t.Errorf("unexpected translation: %s (default: %v)", tr.Subject, usedDefault)
+		}
+	})
+}
+
+func TestMatchTemplateTranslation(t *testing.T) {
+	tDef := types.EmailTemplate{
+		MessageType:     "test-type",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", Subject: "EN"},
+			{Lang: "de", Subject: "DE"},
+		},
+	}
+
+	t.Run("default language used", func(t *testing.T) {
+		tr, usedDefault := MatchTemplateTranslation("test-instance", tDef, "it")
+		if tr.Subject != "EN" || !usedDefault {
+			t.Errorf("unexpected translation: %s (default: %v)", tr.Subject, usedDefault)
+		}
+	})
+
+	t.Run("no language requested", func(t *testing.T) {
+		tr, usedDefault := MatchTemplateTranslation("test-instance", tDef, "")
+		if tr.Subject != "EN" || usedDefault {
+			t.Errorf("unexpected translation: %s (default: %v)", tr.Subject, usedDefault)
+		}
+	})
+}
@@ -0,0 +1,234 @@
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+	"golang.org/x/net/html"
+)
+
+const (
+	LINT_RULE_IMG_ALT         = "img-alt"
+	LINT_RULE_ABSOLUTE_URL    = "absolute-url"
+	LINT_RULE_UNSUPPORTED_CSS = "unsupported-css"
+	LINT_RULE_SIZE            = "size"
+	LINT_RULE_HTTPS           = "https"
+
+	// Gmail clips messages with more than 102KB of HTML
+	MAX_EMAIL_CONTENT_SIZE = 102 * 1024
+)
+
+// LintWarning is a possible problem of a template in email clients, which does not prevent saving or sending it
+type LintWarning struct {
+	Language string
+	Rule     string
+	Msg      string
+}
+
+// CSS properties and values with poor support in email clients (e.g. Outlook or Gmail)
+var (
+	unsupportedCSSProperties = map[string]bool{
+		"position":   true,
+		"transform":  true,
+		"transition": true,
+		"animation":  true,
+		"box-shadow": true,
+		"filter":     true,
+	}
+	unsupportedCSSPatterns = []struct {
+		pattern *regexp.Regexp
+		name    string
+	}{
+		{regexp.MustCompile(`(?i)display\s*:\s*(inline-)?flex`), "display: flex"},
+		{regexp.MustCompile(`(?i)display\s*:\s*(inline-)?grid`), "display: grid"},
+		{regexp.MustCompile(`(?i)var\(\s*--`), "CSS variables"},
+		{regexp.MustCompile(`(?i)@import\b`), "@import"},
+	}
+	urlSchemePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// LintHTML checks the HTML of a template: images without alt text, relative URLs, links without https and CSS that
+// is not supported by common email clients. Attribute values starting with a template action are not checked.
+func LintHTML(content string) []LintWarning {
+	warnings := []LintWarning{}
+	seen := map[string]bool{}
+	add := func(rule string, msg string) {
+		if seen[rule+msg] {
+			return
+		}
+		seen[rule+msg] = true
+		warnings = append(warnings, LintWarning{Rule: rule, Msg: msg})
+	}
+
+	z := html.NewTokenizer(strings.NewReader(content))
+	inStyle := false
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		token := z.Token()
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if token.Data == "style" {
+				inStyle = tt == html.StartTagToken
+			}
+			if token.Data == "img" {
+				if _, ok := tokenAttribute(token, "alt"); !ok {
+					src, _ := tokenAttribute(token, "src")
+					add(LINT_RULE_IMG_ALT, "image without alt text: "+src)
+				}
+			}
+			for _, attr := range token.Attr {
+				switch attr.Key {
+				case "href", "src":
+					lintURL(token.Data, attr.Val, add)
+				case "style":
+					for _, msg := range lintCSS(attr.Val) {
+						add(LINT_RULE_UNSUPPORTED_CSS, msg)
+					}
+				}
+			}
+		case html.EndTagToken:
+			if token.Data == "style" {
+				inStyle = false
+			}
+		case html.TextToken:
+			if inStyle {
+				for _, msg := range lintCSS(token.Data) {
+					add(LINT_RULE_UNSUPPORTED_CSS, msg)
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+func lintURL(tag string, value string, add func(rule string, msg string)) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		add(LINT_RULE_ABSOLUTE_URL, "empty URL in <"+tag+">")
+		return
+	}
+	if strings.HasPrefix(value, "{{") || strings.HasPrefix(value, "#") {
+		// set when the template is resolved, or a link within the email
+		return
+	}
+	lower := strings.ToLower(value)
+	switch {
+	case strings.HasPrefix(lower, "http://"):
+		add(LINT_RULE_HTTPS, "URL without https: "+value)
+	case strings.HasPrefix(lower, "https://"), strings.HasPrefix(lower, "mailto:"), strings.HasPrefix(lower, "tel:"),
+		strings.HasPrefix(lower, "cid:"), strings.HasPrefix(lower, "data:"):
+	case strings.HasPrefix(lower, "//"), !urlSchemePattern.MatchString(value):
+		add(LINT_RULE_ABSOLUTE_URL, "URL is not absolute: "+value)
+	}
+}
+
+func lintCSS(css string) []string {
+	msgs := []string{}
+	for _, d := range parseDeclarations(cssCommentPattern.ReplaceAllString(stripCSSSelectors(css), "")) {
+		if unsupportedCSSProperties[d.property] {
+			msgs = append(msgs, "CSS property `"+d.property+"` is not supported by some email clients")
+		}
+	}
+	for _, p := range unsupportedCSSPatterns {
+		if p.pattern.MatchString(css) {
+			msgs = append(msgs, p.name+" is not supported by some email clients")
+		}
+	}
+	return msgs
+}
+
+// stripCSSSelectors turns the rules of a stylesheet into a list of declarations, style attributes are not changed
+func stripCSSSelectors(css string) string {
+	if !strings.Contains(css, "{") {
+		return css
+	}
+	var sb strings.Builder
+	for _, part := range strings.Split(css, "}") {
+		if i := strings.LastIndex(part, "{"); i >= 0 {
+			sb.WriteString(part[i+1:])
+			sb.WriteString(";")
+		}
+	}
+	return sb.String()
+}
+
+// LintSize warns if the rendered content may be clipped by Gmail
+func LintSize(content string) []LintWarning {
+	if len(content) <= MAX_EMAIL_CONTENT_SIZE {
+		return []LintWarning{}
+	}
+	return []LintWarning{{
+		Rule: LINT_RULE_SIZE,
+		Msg:  fmt.Sprintf("content has %dKB, Gmail clips emails larger than 102KB", len(content)/1024),
+	}}
+}
+
+// lintPartials lints the layout and the partials the content includes, in the language of shared
+func lintPartials(shared *SharedTemplates, content string) []LintWarning {
+	warnings := []LintWarning{}
+	for _, name := range shared.usedPartials(content) {
+		for _, w := range LintHTML(shared.Partials[name]) {
+			w.Msg = "partial `" + name + "`: " + w.Msg
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
+
+// LintTemplate lints each translation of tDef with its layout and the partials it includes, and the size of the
+// translation rendered without data. Translations that cannot be decoded or resolved are skipped, see
+// CheckAllTranslationsParsable.
+func LintTemplate(instanceID string, tDef types.EmailTemplate, partials []types.TemplatePartial) []LintWarning {
+	warnings := []LintWarning{}
+	for _, translation := range tDef.Translations {
+		decoded, err := DecodeTemplateDef(translation)
+		if err != nil {
+			continue
+		}
+		found := LintHTML(decoded)
+
+		shared, err := NewSharedTemplates(instanceID, partials, tDef.Layout, translation.Lang)
+		if err == nil {
+			found = append(found, lintPartials(shared, decoded)...)
+			content, err := executeTemplate(tDef.MessageType+translation.Lang, decoded, shared, TemplateData{})
+			if err == nil {
+				if tDef.InlineCSS {
+					content, _ = InlineCSS(content)
+				}
+				found = append(found, LintSize(content)...)
+			}
+		}
+		for _, w := range found {
+			w.Language = translation.Lang
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
+
+func LintWarningsToAPI(warnings []LintWarning) []*api.TemplateLintWarning {
+	res := make([]*api.TemplateLintWarning, len(warnings))
+	for i, w := range warnings {
+		res[i] = &api.TemplateLintWarning{
+			Language: w.Language,
+			Rule:     w.Rule,
+			Msg:      w.Msg,
+		}
+	}
+	return res
+}
+
+func tokenAttribute(token html.Token, key string) (string, bool) {
+	for _, attr := range token.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
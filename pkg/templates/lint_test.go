@@ -0,0 +1,120 @@
+package templates
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func hasLintWarning(warnings []LintWarning, rule string, msgPart string) bool {
+	for _, w := range warnings {
+		if w.Rule == rule && strings.Contains(w.Msg, msgPart) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLintHTML(t *testing.T) {
+	t.Run("without problems", func(t *testing.T) {
+		warnings := LintHTML(`<p style="color: red">Hi {{.name}}</p>
+<a href="{{.studyURL}}/survey">survey</a> <a href="https://example.com">home</a> <a href="mailto:info@example.com">mail</a> <a href="#top">top</a>
+<img src="https://example.com/logo.png" alt="Logo"><img src="https://example.com/spacer.gif" alt="">`)
+		if len(warnings) != 0 {
+			t.Errorf("unexpected warnings: %v", warnings)
+		}
+	})
+
+	t.Run("with image without alt", func(t *testing.T) {
+		warnings := LintHTML(`<img src="https://example.com/logo.png">`)
+		if len(warnings) != 1 || !hasLintWarning(warnings, LINT_RULE_IMG_ALT, "logo.png") {
+			t.Errorf("unexpected warnings: %v", warnings)
+		}
+	})
+
+	t.Run("with URLs", func(t *testing.T) {
+		warnings := LintHTML(`<a href="/survey">a</a><a href="http://example.com">b</a><a href="http://example.com">c</a><img src="logo.png" alt="Logo"><a href="">d</a>`)
+		if len(warnings) != 4 ||
+			!hasLintWarning(warnings, LINT_RULE_ABSOLUTE_URL, "/survey") ||
+			!hasLintWarning(warnings, LINT_RULE_ABSOLUTE_URL, "logo.png") ||
+			!hasLintWarning(warnings, LINT_RULE_ABSOLUTE_URL, "empty URL") ||
+			!hasLintWarning(warnings, LINT_RULE_HTTPS, "http://example.com") {
+			t.Errorf("unexpected warnings: %v", warnings)
+		}
+	})
+
+	t.Run("with unsupported CSS", func(t *testing.T) {
+		warnings := LintHTML(`<style>
+@import url("https://example.com/style.css");
+.box { display: flex; position: absolute; color: var(--main); }
+@media (max-width: 600px) { .box { box-shadow: none; } }
+</style><div class="box" style="transform: rotate(1deg); color: red">box</div>`)
+		for _, part := range []string{"@import", "display: flex", "`position`", "CSS variables", "`box-shadow`", "`transform`"} {
+			if !hasLintWarning(warnings, LINT_RULE_UNSUPPORTED_CSS, part) {
+				t.Errorf("missing warning for %s: %v", part, warnings)
+			}
+		}
+		if len(warnings) != 6 {
+			t.Errorf("unexpected warnings: %v", warnings)
+		}
+	})
+}
+
+func TestLintTemplate(t *testing.T) {
+	tDef := types.EmailTemplate{
+		MessageType:     "test-type",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: base64.StdEncoding.EncodeToString([]byte(`<p>` + strings.Repeat("a", MAX_EMAIL_CONTENT_SIZE) + `</p>`))},
+			{Lang: "de", Format: types.TEMPLATE_FORMAT_MARKDOWN, TemplateDef: base64.StdEncoding.EncodeToString([]byte(`[Umfrage](http://example.com)`))},
+			{Lang: "fr", TemplateDef: "invalid base64"},
+		},
+	}
+	warnings := LintTemplate("", tDef, nil)
+	if len(warnings) != 2 {
+		t.Errorf("unexpected warnings: %v", warnings)
+		return
+	}
+	if warnings[0].Language != "en" || warnings[0].Rule != LINT_RULE_SIZE {
+		t.Errorf("unexpected warning: %v", warnings[0])
+	}
+	if warnings[1].Language != "de" || warnings[1].Rule != LINT_RULE_HTTPS {
+		t.Errorf("unexpected warning: %v", warnings[1])
+	}
+}
+
+func TestLintTemplateWithPartials(t *testing.T) {
+	encode := func(content string) string {
+		return base64.StdEncoding.EncodeToString([]byte(content))
+	}
+	partials := []types.TemplatePartial{
+		{Name: "layout", DefaultLanguage: "en", Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<div style="display: flex">{{template "content" .}}</div>`)},
+		}},
+		{Name: "footer", DefaultLanguage: "en", Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<p>{{template "logo" .}}</p>`)},
+		}},
+		{Name: "logo", DefaultLanguage: "en", Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<img src="https://example.com/logo.png">`)},
+		}},
+		{Name: "unused", DefaultLanguage: "en", Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<a href="http://example.com">a</a>`)},
+		}},
+	}
+	tDef := types.EmailTemplate{
+		MessageType:     "test-type",
+		DefaultLanguage: "en",
+		Layout:          "layout",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<p>Hi</p>{{template "footer" .}}`)},
+		},
+	}
+	warnings := LintTemplate("", tDef, partials)
+	if len(warnings) != 2 ||
+		!hasLintWarning(warnings, LINT_RULE_UNSUPPORTED_CSS, "partial `layout`") ||
+		!hasLintWarning(warnings, LINT_RULE_IMG_ALT, "partial `logo`") {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
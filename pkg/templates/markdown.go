@@ -0,0 +1,62 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+)
+
+var (
+	templateActionPattern = regexp.MustCompile(`(?s)\{\{.*?\}\}`)
+	// lines with only template actions, e.g. {{range .surveys}} or {{end}}, which must not end up inside a paragraph
+	blockActionLinePattern = regexp.MustCompile(`^(\{\{.*?\}\}[ \t]*)+$`)
+	placeholderPattern     = regexp.MustCompile(`TMPLACTION(\d+)X`)
+
+	// CommonMark without raw HTML: HTML in the source is omitted and links with unsafe schemes are dropped
+	markdownRenderer = goldmark.New()
+)
+
+func actionPlaceholder(i int) string {
+	return fmt.Sprintf("TMPLACTION%dX", i)
+}
+
+// MarkdownToHTMLTemplate converts a markdown template into an html/template definition. Template actions are kept
+// as they are, and lines with only actions (e.g. {{range .surveys}} and {{end}}) are placed between the HTML blocks.
+func MarkdownToHTMLTemplate(src string) (string, error) {
+	actions := []string{}
+	protect := func(action string) string {
+		actions = append(actions, action)
+		return actionPlaceholder(len(actions) - 1)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if blockActionLinePattern.MatchString(strings.TrimRight(line, " \t")) {
+			// blank lines around it, so it is a paragraph of its own that can be unwrapped after rendering
+			lines[i] = "\n" + protect(strings.TrimRight(line, " \t")) + "\n"
+		}
+	}
+	protected := templateActionPattern.ReplaceAllStringFunc(strings.Join(lines, "\n"), protect)
+
+	var buf bytes.Buffer
+	if err := markdownRenderer.Convert([]byte(protected), &buf); err != nil {
+		return "", err
+	}
+
+	rendered := buf.String()
+	for i, action := range actions {
+		rendered = strings.Replace(rendered, "<p>"+actionPlaceholder(i)+"</p>", action, 1)
+	}
+	rendered = placeholderPattern.ReplaceAllStringFunc(rendered, func(p string) string {
+		i, err := strconv.Atoi(placeholderPattern.FindStringSubmatch(p)[1])
+		if err != nil || i >= len(actions) {
+			return p
+		}
+		return actions[i]
+	})
+	return rendered, nil
+}
@@ -0,0 +1,132 @@
+package templates
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestMarkdownToHTMLTemplate(t *testing.T) {
+	t.Run("with markdown and actions", func(t *testing.T) {
+		def, err := MarkdownToHTMLTemplate("# Hello {{.name}}\n\nPlease fill in [the survey]({{.url}}).")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		expected := "<h1>Hello {{.name}}</h1>\n<p>Please fill in <a href=\"{{.url}}\">the survey</a>.</p>\n"
+		if def != expected {
+			t.Errorf("unexpected result: %s", def)
+		}
+	})
+
+	t.Run("with range block", func(t *testing.T) {
+		def, err := MarkdownToHTMLTemplate("Surveys:\n{{range .surveys}}\n- {{.name}}\n{{end}}\n")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		content, err := ResolveTemplate("test", def, nil, TemplateData{
+			"surveys": []interface{}{
+				map[string]interface{}{"name": "weekly"},
+				map[string]interface{}{"name": "intake"},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if strings.Contains(content, "<p>{{") || strings.Count(content, "<li>") != 2 || !strings.Contains(content, "weekly") {
+			t.Errorf("unexpected content: %s", content)
+		}
+	})
+
+	t.Run("with raw HTML", func(t *testing.T) {
+		def, err := MarkdownToHTMLTemplate("text <script>alert(1)</script>\n\n<div onclick=\"x()\">block</div>")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if strings.Contains(def, "<script>") || strings.Contains(def, "onclick") {
+			t.Errorf("raw HTML not omitted: %s", def)
+		}
+	})
+
+	t.Run("with javascript link", func(t *testing.T) {
+		def, err := MarkdownToHTMLTemplate("[click](javascript:alert(1))")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if strings.Contains(def, "javascript:") {
+			t.Errorf("unsafe link not dropped: %s", def)
+		}
+	})
+}
+
+func TestHTMLToText(t *testing.T) {
+	text := HTMLToText(`<html><head><style>p { color: red; }</style></head><body>
+<h1>Hello  Tester</h1>
+<p>Please fill in <a href="https://example.com/s">the survey</a>.<br>Thanks</p>
+<ul><li>first</li><li>second</li></ul>
+<ol><li>one</li><li>two</li></ol>
+</body></html>`)
+	expected := "Hello Tester\n\nPlease fill in the survey (https://example.com/s).\nThanks\n\n- first\n- second\n\n1. one\n2. two"
+	if text != expected {
+		t.Errorf("unexpected text:\n%s", text)
+	}
+}
+
+func TestResolveTranslation(t *testing.T) {
+	shared := &SharedTemplates{
+		Partials: map[string]string{
+			"layout": `<html><body><h1>Layout</h1>{{template "content" .}}</body></html>`,
+		},
+		Layout: "layout",
+	}
+
+	t.Run("with unknown format", func(t *testing.T) {
+		_, err := ResolveTranslation("test", types.EmailTemplate{}, types.LocalizedTemplate{
+			Lang:        "en",
+			Format:      "rtf",
+			TemplateDef: base64.StdEncoding.EncodeToString([]byte("text")),
+		}, shared, TemplateData{})
+		if err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("with html template", func(t *testing.T) {
+		email, err := ResolveTranslation("test", types.EmailTemplate{}, types.LocalizedTemplate{
+			Lang:        "en",
+			Subject:     "Subject",
+			TemplateDef: base64.StdEncoding.EncodeToString([]byte("<p>Hi {{.name}}</p>")),
+		}, shared, TemplateData{"name": "Tester"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if email.Subject != "Subject" || !strings.Contains(email.Content, "<h1>Layout</h1><p>Hi Tester</p>") || email.TextContent != "" {
+			t.Errorf("unexpected email: %v", email)
+		}
+	})
+
+	t.Run("with markdown template", func(t *testing.T) {
+		email, err := ResolveTranslation("test", types.EmailTemplate{}, types.LocalizedTemplate{
+			Lang:        "en",
+			Format:      types.TEMPLATE_FORMAT_MARKDOWN,
+			TemplateDef: base64.StdEncoding.EncodeToString([]byte("Hi **{{.name}}**")),
+		}, shared, TemplateData{"name": "<Tester>"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if !strings.Contains(email.Content, "<h1>Layout</h1><p>Hi <strong>&lt;Tester&gt;</strong></p>") {
+			t.Errorf("unexpected content: %s", email.Content)
+		}
+		if email.TextContent != "Hi <Tester>" {
+			t.Errorf("unexpected text content: %s", email.TextContent)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package templates
+
+import (
+	"errors"
+	"html/template"
+	"regexp"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// LAYOUT_CONTENT_BLOCK is the name under which the message is available in a layout: {{template "content" .}}
+const LAYOUT_CONTENT_BLOCK = "content"
+
+// templateAction matches the inclusion of a partial: {{template "name" .}}
+var templateAction = regexp.MustCompile(`\{\{-?\s*template\s+"([^"]+)"`)
+
+// SharedTemplates are the decoded partials of an instance in one language, and the layout a template is wrapped in
+type SharedTemplates struct {
+	Partials map[string]string
+	Layout   string
+}
+
+// NewSharedTemplates selects the translation of each partial for lang, with the language fallbacks of the instance
+func NewSharedTemplates(instanceID string, partials []types.TemplatePartial, layout string, lang string) (*SharedTemplates, error) {
+	shared := &SharedTemplates{
+		Partials: map[string]string{},
+		Layout:   layout,
+	}
+	fallbacks := LoadLanguageFallbacks()[instanceID]
+	for _, p := range partials {
+		translation, _ := selectTranslation(p.DefaultLanguage, p.Translations, lang, fallbacks)
+		decoded, err := DecodeTemplateDef(translation)
+		if err != nil {
+			return nil, errors.New("error when decoding partial `" + p.Name + "`: " + err.Error())
+		}
+		shared.Partials[p.Name] = decoded
+	}
+	return shared, nil
+}
+
+// withoutLayout keeps the partials, e.g. to render the plain-text part of a message
+func (shared *SharedTemplates) withoutLayout() *SharedTemplates {
+	if shared == nil {
+		return nil
+	}
+	return &SharedTemplates{
+		Partials: shared.Partials,
+	}
+}
+
+// parseWithSharedTemplates returns the template set and the name of the template to execute
+func parseWithSharedTemplates(tempName string, templateDef string, shared *SharedTemplates) (*template.Template, string, error) {
+	tmpl := newTemplate(tempName)
+	if shared != nil {
+		for name, def := range shared.Partials {
+			if _, err := tmpl.New(name).Parse(def); err != nil {
+				return nil, "", errors.New("error in partial `" + name + "`: " + err.Error())
+			}
+		}
+	}
+	if shared == nil || shared.Layout == "" {
+		_, err := tmpl.Parse(templateDef)
+		return tmpl, tempName, err
+	}
+
+	if tmpl.Lookup(shared.Layout) == nil {
+		return nil, "", errors.New("layout `" + shared.Layout + "` not found")
+	}
+	if _, err := tmpl.New(LAYOUT_CONTENT_BLOCK).Parse(templateDef); err != nil {
+		return nil, "", err
+	}
+	return tmpl, shared.Layout, nil
+}
+
+// CheckPartialParsable returns an error if a translation of the partial cannot be parsed
+func CheckPartialParsable(partial types.TemplatePartial) error {
+	if partial.Name == "" || partial.Name == LAYOUT_CONTENT_BLOCK {
+		return errors.New("invalid partial name `" + partial.Name + "`")
+	}
+	if len(partial.Translations) == 0 {
+		return errors.New("error when decoding partial `" + partial.Name + "`: translation list is empty")
+	}
+	for _, tr := range partial.Translations {
+		decoded, err := DecodeTemplateDef(tr)
+		if err != nil {
+			return errors.New("error when decoding partial `" + partial.Name + "` for `" + tr.Lang + "`: " + err.Error())
+		}
+		if _, err := newTemplate(partial.Name).Parse(decoded); err != nil {
+			return errors.New("could not parse partial for `" + tr.Lang + "` - error: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// includedPartials returns the names of the partials the content includes
+func includedPartials(content string) []string {
+	names := []string{}
+	for _, match := range templateAction.FindAllStringSubmatch(content, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// referencedPartials returns the names of the partials the translations include
+func referencedPartials(translations []types.LocalizedTemplate) map[string]bool {
+	names := map[string]bool{}
+	for _, tr := range translations {
+		decoded, err := DecodeTemplateDef(tr)
+		if err != nil {
+			continue
+		}
+		for _, name := range includedPartials(decoded) {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// usedPartials returns the names of the layout and the partials the content includes, directly or through other
+// partials, in the order they are found
+func (shared *SharedTemplates) usedPartials(content string) []string {
+	if shared == nil {
+		return []string{}
+	}
+	used := []string{}
+	seen := map[string]bool{}
+	queue := includedPartials(content)
+	if shared.Layout != "" {
+		queue = append([]string{shared.Layout}, queue...)
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		def, ok := shared.Partials[name]
+		if seen[name] || !ok {
+			continue
+		}
+		seen[name] = true
+		used = append(used, name)
+		queue = append(queue, includedPartials(def)...)
+	}
+	return used
+}
+
+// PartialsUsing returns the name of the partial and the names of the partials including it, directly or through other partials
+func PartialsUsing(partials []types.TemplatePartial, name string) map[string]bool {
+	using := map[string]bool{name: true}
+	for changed := true; changed; {
+		changed = false
+		for _, p := range partials {
+			if using[p.Name] {
+				continue
+			}
+			for ref := range referencedPartials(p.Translations) {
+				if using[ref] {
+					using[p.Name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return using
+}
+
+// UsesPartials returns true if the template is wrapped in one of the partials or includes one of them
+func UsesPartials(tDef types.EmailTemplate, names map[string]bool) bool {
+	if names[tDef.Layout] {
+		return true
+	}
+	for ref := range referencedPartials(tDef.Translations) {
+		if names[ref] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package templates
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestTemplatePartials(t *testing.T) {
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+	partials := []types.TemplatePartial{
+		{
+			Name:            "footer",
+			DefaultLanguage: "en",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`<footer>Bye {{.name}}</footer>`)},
+				{Lang: "de", TemplateDef: encode(`<footer>Tschüss {{.name}}</footer>`)},
+			},
+		},
+		{
+			Name:            "layout",
+			DefaultLanguage: "en",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`<html><body>{{template "content" .}}{{template "footer" .}}</body></html>`)},
+			},
+		},
+	}
+	contentInfos := TemplateData{"name": "Tester"}
+
+	t.Run("select translation of partials", func(t *testing.T) {
+		shared, err := NewSharedTemplates("", partials, "", "fr")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if shared.Partials["footer"] != `<footer>Bye {{.name}}</footer>` {
+			t.Errorf("unexpected partial: %s", shared.Partials["footer"])
+		}
+	})
+
+	t.Run("template using a partial", func(t *testing.T) {
+		shared, _ := NewSharedTemplates("", partials, "", "de")
+		content, err := ResolveTemplate("test", `<p>Hi</p>{{template "footer" .}}`, shared, contentInfos)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if content != `<p>Hi</p><footer>Tschüss Tester</footer>` {
+			t.Errorf("unexpected content: %s", content)
+		}
+	})
+
+	t.Run("template with layout", func(t *testing.T) {
+		shared, _ := NewSharedTemplates("", partials, "layout", "en")
+		content, err := ResolveTemplate("test", `<p>Hi {{.name}}</p>`, shared, contentInfos)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if content != `<html><body><p>Hi Tester</p><footer>Bye Tester</footer></body></html>` {
+			t.Errorf("unexpected content: %s", content)
+		}
+	})
+
+	t.Run("template with missing layout", func(t *testing.T) {
+		shared, _ := NewSharedTemplates("", partials, "wrong", "en")
+		_, err := ResolveTemplate("test", `<p>Hi</p>`, shared, contentInfos)
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("check translations against partials", func(t *testing.T) {
+		tDef := types.EmailTemplate{
+			MessageType: "test",
+			Layout:      "layout",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`<p>{{template "footer" .}}</p>`)},
+			},
+		}
+		if err := CheckAllTranslationsParsable("", tDef, partials); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		tDef.Translations[0].TemplateDef = encode(`<p>{{template "header" .}}</p>`)
+		if err := CheckAllTranslationsParsable("", tDef, partials); err == nil {
+			t.Error("should return an error for missing partial")
+		}
+	})
+
+	t.Run("check partial", func(t *testing.T) {
+		if err := CheckPartialParsable(partials[0]); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := CheckPartialParsable(types.TemplatePartial{Name: "content", Translations: partials[0].Translations}); err == nil {
+			t.Error("should return an error for reserved name")
+		}
+		if err := CheckPartialParsable(types.TemplatePartial{Name: "broken", Translations: []types.LocalizedTemplate{{Lang: "en", TemplateDef: encode("{{.a")}}}); err == nil {
+			t.Error("should return an error for invalid template")
+		}
+	})
+
+	t.Run("partials using a partial", func(t *testing.T) {
+		using := PartialsUsing([]types.TemplatePartial{
+			{Name: "footer", Translations: []types.LocalizedTemplate{{Lang: "en", TemplateDef: encode(`<p>footer</p>`)}}},
+			{Name: "signature", Translations: []types.LocalizedTemplate{{Lang: "en", TemplateDef: encode(`{{- template "footer" .}}`)}}},
+			{Name: "layout", Translations: []types.LocalizedTemplate{{Lang: "en", TemplateDef: encode(`{{template "content" .}}{{ template "signature" . }}`)}}},
+			{Name: "header", Translations: []types.LocalizedTemplate{{Lang: "en", TemplateDef: encode(`<p>header</p>`)}}},
+		}, "footer")
+		if len(using) != 3 || !using["footer"] || !using["signature"] || !using["layout"] {
+			t.Errorf("unexpected result: %v", using)
+		}
+	})
+
+	t.Run("template using partials", func(t *testing.T) {
+		tDef := types.EmailTemplate{
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`<p>{{template "footer" .}}</p>`)},
+			},
+		}
+		if !UsesPartials(tDef, map[string]bool{"footer": true}) {
+			t.Error("should use included partial")
+		}
+		if UsesPartials(tDef, map[string]bool{"layout": true}) {
+			t.Error("should not use other partial")
+		}
+		tDef.Layout = "layout"
+		if !UsesPartials(tDef, map[string]bool{"layout": true}) {
+			t.Error("should use layout")
+		}
+	})
+}
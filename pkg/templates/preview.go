@@ -0,0 +1,125 @@
+package templates
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// TemplateError is a parse or execution error of a template with its position, if known (line and column start at 1, 0 if unknown)
+type TemplateError struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+// TemplatePreview is the result of rendering one translation of a template with sample data
+type TemplatePreview struct {
+	Language    string
+	Subject     string
+	Content     string
+	TextContent string
+	Errors      []TemplateError
+	Warnings    []LintWarning
+}
+
+// matches e.g. "template: name:2: unclosed action", "template: name:2:7: executing ..." or "html/template:name:2:14: no such template"
+var templateErrorPattern = regexp.MustCompile(`^(?:html/)?template: ?[^:]*(?::(\d+))?(?::(\d+))?: (.*)$`)
+
+// ParseTemplateError extracts line and column from the error strings of html/template
+func ParseTemplateError(err error) TemplateError {
+	msg := err.Error()
+	match := templateErrorPattern.FindStringSubmatch(msg)
+	if match == nil {
+		return TemplateError{Msg: msg}
+	}
+	line, _ := strconv.Atoi(match[1])
+	column, _ := strconv.Atoi(match[2])
+	return TemplateError{
+		Line:   line,
+		Column: column,
+		Msg:    match[3],
+	}
+}
+
+// RenderTemplatePreview renders the translation of tDef selected for lang. The sample data is merged with
+// the global template constants the same way as when a message is sent. Errors are returned as part of the preview.
+func RenderTemplatePreview(instanceID string, tDef types.EmailTemplate, partials []types.TemplatePartial, lang string, sampleData TemplateData) TemplatePreview {
+	translation, _ := SelectTemplateTranslation(instanceID, tDef, lang)
+	preview := TemplatePreview{
+		Language: translation.Lang,
+		Subject:  translation.Subject,
+		Errors:   []TemplateError{},
+		Warnings: []LintWarning{},
+	}
+
+	decodedTemplate, err := DecodeTemplateDef(translation)
+	if err != nil {
+		preview.Errors = append(preview.Errors, TemplateError{Msg: "template could not be decoded: " + err.Error()})
+		return preview
+	}
+	if strings.TrimSpace(decodedTemplate) == "" {
+		preview.Errors = append(preview.Errors, TemplateError{Msg: "empty template for language `" + lang + "`"})
+		return preview
+	}
+
+	data := sampleData.Copy()
+	data.AddStrings(LoadGlobalEmailTemplateConstants())
+	data["language"] = lang
+
+	shared, err := NewSharedTemplates(instanceID, partials, tDef.Layout, lang)
+	if err != nil {
+		preview.Errors = append(preview.Errors, TemplateError{Msg: err.Error()})
+		return preview
+	}
+	content, err := executeTemplate(tDef.MessageType+lang, decodedTemplate, shared, data)
+	if err != nil {
+		preview.Errors = append(preview.Errors, ParseTemplateError(err))
+		return preview
+	}
+	if tDef.InlineCSS {
+		content, err = InlineCSS(content)
+		if err != nil {
+			preview.Errors = append(preview.Errors, TemplateError{Msg: "CSS could not be inlined: " + err.Error()})
+			return preview
+		}
+	}
+	preview.Content = content
+	found := append(LintHTML(decodedTemplate), lintPartials(shared, decodedTemplate)...)
+	for _, w := range append(found, LintSize(content)...) {
+		w.Language = translation.Lang
+		preview.Warnings = append(preview.Warnings, w)
+	}
+
+	if translation.IsMarkdown() {
+		body, err := executeTemplate(tDef.MessageType+lang, decodedTemplate, shared.withoutLayout(), data)
+		if err != nil {
+			preview.Errors = append(preview.Errors, ParseTemplateError(err))
+			return preview
+		}
+		preview.TextContent = HTMLToText(body)
+	}
+	return preview
+}
+
+func (p TemplatePreview) ToAPI() *api.EmailTemplatePreview {
+	errs := make([]*api.TemplateError, len(p.Errors))
+	for i, e := range p.Errors {
+		errs[i] = &api.TemplateError{
+			Line:   int32(e.Line),
+			Column: int32(e.Column),
+			Msg:    e.Msg,
+		}
+	}
+	return &api.EmailTemplatePreview{
+		Language:    p.Language,
+		Subject:     p.Subject,
+		Content:     p.Content,
+		TextContent: p.TextContent,
+		Errors:      errs,
+		Warnings:    LintWarningsToAPI(p.Warnings),
+	}
+}
@@ -0,0 +1,110 @@
+package templates
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestParseTemplateError(t *testing.T) {
+	t.Run("parse error with line", func(t *testing.T) {
+		e := ParseTemplateError(errors.New("template: test:2: unclosed action"))
+		if e.Line != 2 || e.Column != 0 || e.Msg != "unclosed action" {
+			t.Errorf("unexpected result: %v", e)
+		}
+	})
+
+	t.Run("execution error with line and column", func(t *testing.T) {
+		e := ParseTemplateError(errors.New("template: test:2:7: executing \"test\" at <.a.b>: can't evaluate field b in type string"))
+		if e.Line != 2 || e.Column != 7 || e.Msg != "executing \"test\" at <.a.b>: can't evaluate field b in type string" {
+			t.Errorf("unexpected result: %v", e)
+		}
+	})
+
+	t.Run("html/template error", func(t *testing.T) {
+		e := ParseTemplateError(errors.New("html/template:test:2:14: no such template \"foo\""))
+		if e.Line != 2 || e.Column != 14 || e.Msg != "no such template \"foo\"" {
+			t.Errorf("unexpected result: %v", e)
+		}
+	})
+
+	t.Run("html/template error without position", func(t *testing.T) {
+		e := ParseTemplateError(errors.New("html/template:test: ends in a non-text context"))
+		if e.Line != 0 || e.Column != 0 || e.Msg != "ends in a non-text context" {
+			t.Errorf("unexpected result: %v", e)
+		}
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		e := ParseTemplateError(errors.New("something else"))
+		if e.Line != 0 || e.Msg != "something else" {
+			t.Errorf("unexpected result: %v", e)
+		}
+	})
+}
+
+func TestRenderTemplatePreview(t *testing.T) {
+	testTemplate := types.EmailTemplate{
+		MessageType:     "test-type",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", Subject: "EN", TemplateDef: base64.StdEncoding.EncodeToString([]byte("<p>Hello {{.name}} ({{.language}})</p>"))},
+			{Lang: "de", Subject: "DE", TemplateDef: base64.StdEncoding.EncodeToString([]byte("<p>Hallo\n{{ .name </p>"))},
+			{Lang: "fr", Subject: "FR", TemplateDef: "not base64"},
+			{Lang: "it", Subject: "IT", TemplateDef: base64.StdEncoding.EncodeToString([]byte(`<a href="http://example.com">{{.name}}</a>`))},
+		},
+	}
+
+	t.Run("valid translation", func(t *testing.T) {
+		preview := RenderTemplatePreview("", testTemplate, nil, "en", TemplateData{"name": "Tester"})
+		if len(preview.Errors) > 0 {
+			t.Errorf("unexpected errors: %v", preview.Errors)
+			return
+		}
+		if preview.Language != "en" || preview.Subject != "EN" || preview.Content != "<p>Hello Tester (en)</p>" {
+			t.Errorf("unexpected preview: %v", preview)
+		}
+	})
+
+	t.Run("fallback to default language", func(t *testing.T) {
+		preview := RenderTemplatePreview("", testTemplate, nil, "es", TemplateData{"name": "Tester"})
+		if len(preview.Errors) > 0 {
+			t.Errorf("unexpected errors: %v", preview.Errors)
+			return
+		}
+		if preview.Language != "en" || preview.Content != "<p>Hello Tester (es)</p>" {
+			t.Errorf("unexpected preview: %v", preview)
+		}
+	})
+
+	t.Run("parse error", func(t *testing.T) {
+		preview := RenderTemplatePreview("", testTemplate, nil, "de", nil)
+		if len(preview.Errors) != 1 {
+			t.Errorf("unexpected errors: %v", preview.Errors)
+			return
+		}
+		if preview.Errors[0].Line != 2 || preview.Content != "" {
+			t.Errorf("unexpected preview: %v", preview)
+		}
+	})
+
+	t.Run("invalid base64", func(t *testing.T) {
+		preview := RenderTemplatePreview("", testTemplate, nil, "fr", nil)
+		if len(preview.Errors) != 1 {
+			t.Errorf("unexpected errors: %v", preview.Errors)
+		}
+	})
+
+	t.Run("with lint warnings", func(t *testing.T) {
+		preview := RenderTemplatePreview("", testTemplate, nil, "it", TemplateData{"name": "Tester"})
+		if len(preview.Errors) > 0 {
+			t.Errorf("unexpected errors: %v", preview.Errors)
+			return
+		}
+		if len(preview.Warnings) != 1 || preview.Warnings[0].Rule != LINT_RULE_HTTPS || preview.Warnings[0].Language != "it" {
+			t.Errorf("unexpected warnings: %v", preview.Warnings)
+		}
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
-	"html/template"
 	"os"
 	"strings"
 
@@ -17,58 +16,129 @@ const (
 	ENV_GLOBAL_EMAIL_TEMPLATE_CONSTANTS_JSON = "GLOBAL_EMAIL_TEMPLATE_CONSTANTS_JSON"
 )
 
-func GetTemplateTranslation(tDef types.EmailTemplate, lang string) types.LocalizedTemplate {
-	var defaultTranslation types.LocalizedTemplate
-	for _, tr := range tDef.Translations {
-		if tr.Lang == lang {
-			return tr
-		} else if tr.Lang == tDef.DefaultLanguage {
-			defaultTranslation = tr
+// GetTemplateTranslation selects the translation of tDef for lang, see MatchTemplateTranslation
+func GetTemplateTranslation(instanceID string, tDef types.EmailTemplate, lang string) types.LocalizedTemplate {
+	translation, _ := MatchTemplateTranslation(instanceID, tDef, lang)
+	return translation
+}
+
+// EmailContent is a translation of a template rendered for a recipient
+type EmailContent struct {
+	Subject     string
+	Content     string
+	TextContent string // plain-text part, only generated for markdown templates
+}
+
+// DecodeTemplateDef decodes the base64 template definition of a translation, markdown is converted to HTML
+func DecodeTemplateDef(translation types.LocalizedTemplate) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(translation.TemplateDef)
+	if err != nil {
+		return "", err
+	}
+	switch translation.Format {
+	case "", types.TEMPLATE_FORMAT_HTML:
+		return string(decoded), nil
+	case types.TEMPLATE_FORMAT_MARKDOWN:
+		return MarkdownToHTMLTemplate(string(decoded))
+	default:
+		return "", errors.New("unknown template format `" + translation.Format + "`")
+	}
+}
+
+// ResolveTranslation renders the subject and content of a translation of tDef. For markdown templates, the plain-text
+// part is generated from the content without the layout. CSS is inlined if enabled for the template.
+func ResolveTranslation(tempName string, tDef types.EmailTemplate, translation types.LocalizedTemplate, shared *SharedTemplates, data TemplateData) (EmailContent, error) {
+	templateDef, err := DecodeTemplateDef(translation)
+	if err != nil {
+		logger.Error.Printf("error when decoding template %s: %v", tempName, err)
+		return EmailContent{}, err
+	}
+	content, err := ResolveTemplate(tempName, templateDef, shared, data)
+	if err != nil {
+		return EmailContent{}, err
+	}
+	if tDef.InlineCSS {
+		content, err = InlineCSS(content)
+		if err != nil {
+			logger.Error.Printf("error when inlining CSS of template %s: %v", tempName, err)
+			return EmailContent{}, err
 		}
 	}
-	return defaultTranslation
+	email := EmailContent{
+		Subject: translation.Subject,
+		Content: content,
+	}
+	if translation.IsMarkdown() {
+		body, err := executeTemplate(tempName, templateDef, shared.withoutLayout(), data)
+		if err != nil {
+			return EmailContent{}, err
+		}
+		email.TextContent = HTMLToText(body)
+	}
+	return email, nil
 }
 
-func ResolveTemplate(tempName string, templateDef string, contentInfos map[string]string) (content string, err error) {
+// ResolveTemplate executes the template with the partials and layout of shared, which can be nil
+func ResolveTemplate(tempName string, templateDef string, shared *SharedTemplates, data TemplateData) (content string, err error) {
 	if strings.TrimSpace(templateDef) == "" {
 		logger.Error.Printf("error: empty template %s", tempName)
 		return "", errors.New("empty template `" + tempName)
 	}
-	tmpl, err := template.New(tempName).Parse(templateDef)
+	content, err = executeTemplate(tempName, templateDef, shared, data)
+	if err != nil {
+		logger.Error.Printf("error when resolving template %s: %v", tempName, err)
+		return "", err
+	}
+	return content, nil
+}
+
+func executeTemplate(tempName string, templateDef string, shared *SharedTemplates, data TemplateData) (string, error) {
+	tmpl, root, err := parseWithSharedTemplates(tempName, templateDef, shared)
 	if err != nil {
-		logger.Error.Printf("error when parsing template %s: %v", tempName, err)
 		return "", err
 	}
 	var tpl bytes.Buffer
 
-	err = tmpl.Execute(&tpl, contentInfos)
+	err = tmpl.ExecuteTemplate(&tpl, root, data.executionValue(templateTrees(tmpl)))
 	if err != nil {
-		logger.Error.Printf("error when executing template %s: %v", tempName, err)
 		return "", err
 	}
 	return tpl.String(), nil
 }
 
-func CheckAllTranslationsParsable(tempTranslations types.EmailTemplate) (err error) {
+// CheckAllTranslationsParsable resolves each translation with the partials of the instance and the layout of the template,
+// and the SMS and push variants of the translations that have one
+func CheckAllTranslationsParsable(instanceID string, tempTranslations types.EmailTemplate, partials []types.TemplatePartial) (err error) {
 	if len(tempTranslations.Translations) == 0 {
 		logger.Error.Printf("error when decoding template %s: translation list is empty", tempTranslations.MessageType)
 		return errors.New("error when decoding template `" + tempTranslations.MessageType + "`: translation list is empty")
 	}
 	for _, templ := range tempTranslations.Translations {
 		templateName := tempTranslations.MessageType + templ.Lang
-		decodedTemplate, err := base64.StdEncoding.DecodeString(templ.TemplateDef)
+		shared, err := NewSharedTemplates(instanceID, partials, tempTranslations.Layout, templ.Lang)
 		if err != nil {
-			logger.Error.Printf("error when decoding template %s: %v", templateName, err)
 			return err
 		}
-		_, err = ResolveTemplate(
+		_, err = ResolveTranslation(
 			templateName,
-			string(decodedTemplate),
-			make(map[string]string),
+			tempTranslations,
+			templ,
+			shared,
+			TemplateData{},
 		)
 		if err != nil {
 			return errors.New("could not parse template for `" + templ.Lang + "` - error: " + err.Error())
 		}
+		if templ.SMSTemplate != "" {
+			if _, err := ResolveSMSTemplate(templateName, templ, TemplateData{}); err != nil {
+				return errors.New("could not parse SMS template for `" + templ.Lang + "` - error: " + err.Error())
+			}
+		}
+		if templ.PushTemplate != "" {
+			if _, err := ResolvePushTemplate(templateName, templ, TemplateData{}); err != nil {
+				return errors.New("could not parse push template for `" + templ.Lang + "` - error: " + err.Error())
+			}
+		}
 	}
 	return nil
 }
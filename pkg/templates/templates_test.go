@@ -17,14 +17,14 @@ func TestTemplateLanguageSelection(t *testing.T) {
 	}
 
 	t.Run("missing target language", func(t *testing.T) {
-		translation := GetTemplateTranslation(testTemplate, "fr")
+		translation := GetTemplateTranslation("", testTemplate, "fr")
 		if translation.Subject != "EN" {
 			t.Errorf("unexpected translation found: %v", translation)
 		}
 	})
 
 	t.Run("existing target language", func(t *testing.T) {
-		translation := GetTemplateTranslation(testTemplate, "de")
+		translation := GetTemplateTranslation("", testTemplate, "de")
 		if translation.Subject != "DE" {
 			t.Errorf("unexpected translation found: %v", translation)
 		}
@@ -32,12 +32,12 @@ func TestTemplateLanguageSelection(t *testing.T) {
 }
 
 func TestResolveTemplate(t *testing.T) {
-	contentInfos := map[string]string{
+	contentInfos := TemplateData{
 		"testKey1": "value1",
 		"testKey2": "value2",
 	}
 	t.Run("with static template", func(t *testing.T) {
-		content, err := ResolveTemplate("testTemp1", "<h1>Test</h1>", contentInfos)
+		content, err := ResolveTemplate("testTemp1", "<h1>Test</h1>", nil, contentInfos)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -48,7 +48,7 @@ func TestResolveTemplate(t *testing.T) {
 	})
 
 	t.Run("with dynamic template missing info", func(t *testing.T) {
-		content, err := ResolveTemplate("testTemp2", "<h1>{{index . \"testKey3\"}}</h1>", contentInfos)
+		content, err := ResolveTemplate("testTemp2", "<h1>{{index . \"testKey3\"}}</h1>", nil, contentInfos)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -59,7 +59,7 @@ func TestResolveTemplate(t *testing.T) {
 	})
 
 	t.Run("with dynamic template valid infos", func(t *testing.T) {
-		content, err := ResolveTemplate("testTemp3", `<h1>{{index . "testKey1"}}</h1>`, contentInfos)
+		content, err := ResolveTemplate("testTemp3", `<h1>{{index . "testKey1"}}</h1>`, nil, contentInfos)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
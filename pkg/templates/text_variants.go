@@ -0,0 +1,92 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// PushContent is a translation of a template rendered as web push notification
+type PushContent struct {
+	Title string
+	Body  string
+}
+
+// ResolveSMSTemplate renders the SMS variant of a translation. It is plain text executed with text/template, so values
+// are not escaped, and it can use the template functions but no partials or layout. Surrounding whitespace is removed.
+func ResolveSMSTemplate(tempName string, translation types.LocalizedTemplate, data TemplateData) (string, error) {
+	if strings.TrimSpace(translation.SMSTemplate) == "" {
+		return "", errors.New("no SMS variant in template `" + tempName + "`")
+	}
+	return executeTextTemplate(tempName, translation.SMSTemplate, data)
+}
+
+// ResolvePushTemplate renders the push variant of a translation like ResolveSMSTemplate, the subject is the title
+func ResolvePushTemplate(tempName string, translation types.LocalizedTemplate, data TemplateData) (PushContent, error) {
+	if strings.TrimSpace(translation.PushTemplate) == "" {
+		return PushContent{}, errors.New("no push variant in template `" + tempName + "`")
+	}
+	body, err := executeTextTemplate(tempName, translation.PushTemplate, data)
+	if err != nil {
+		return PushContent{}, err
+	}
+	return PushContent{
+		Title: translation.Subject,
+		Body:  body,
+	}, nil
+}
+
+func executeTextTemplate(tempName string, templateDef string, data TemplateData) (string, error) {
+	tmpl, err := texttemplate.New(tempName).Funcs(texttemplate.FuncMap(TemplateFuncs)).Parse(templateDef)
+	if err != nil {
+		logger.Error.Printf("error when parsing template %s: %v", tempName, err)
+		return "", err
+	}
+	var buf bytes.Buffer
+	trees := []*parse.Tree{}
+	for _, t := range tmpl.Templates() {
+		trees = append(trees, t.Tree)
+	}
+	if err := tmpl.Execute(&buf, data.executionValue(trees)); err != nil {
+		logger.Error.Printf("error when resolving template %s: %v", tempName, err)
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// MatchSMSTranslation is SelectTemplateTranslation among the translations with an SMS variant. If the default language
+// has no SMS variant either, the returned translation is empty. The fallback is not logged, the bulk generators log it
+// once per run.
+func MatchSMSTranslation(instanceID string, tDef types.EmailTemplate, lang string) (translation types.LocalizedTemplate, usedDefault bool) {
+	return matchVariantTranslation(instanceID, tDef, lang, func(tr types.LocalizedTemplate) bool {
+		return tr.SMSTemplate != ""
+	})
+}
+
+// MatchPushTranslation is MatchSMSTranslation for the push variant
+func MatchPushTranslation(instanceID string, tDef types.EmailTemplate, lang string) (translation types.LocalizedTemplate, usedDefault bool) {
+	return matchVariantTranslation(instanceID, tDef, lang, func(tr types.LocalizedTemplate) bool {
+		return tr.PushTemplate != ""
+	})
+}
+
+func matchVariantTranslation(
+	instanceID string,
+	tDef types.EmailTemplate,
+	lang string,
+	hasVariant func(tr types.LocalizedTemplate) bool,
+) (translation types.LocalizedTemplate, usedDefault bool) {
+	withVariant := []types.LocalizedTemplate{}
+	for _, tr := range tDef.Translations {
+		if hasVariant(tr) {
+			withVariant = append(withVariant, tr)
+		}
+	}
+	translation, usedDefault = selectTranslation(tDef.DefaultLanguage, withVariant, lang, LoadLanguageFallbacks()[instanceID])
+	return translation, usedDefault && lang != ""
+}
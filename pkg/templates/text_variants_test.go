@@ -0,0 +1,140 @@
+package templates
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestResolveSMSTemplate(t *testing.T) {
+	t.Run("without SMS variant", func(t *testing.T) {
+		_, err := ResolveSMSTemplate("test", types.LocalizedTemplate{Lang: "en"}, TemplateData{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("with text and functions", func(t *testing.T) {
+		text, err := ResolveSMSTemplate("test", types.LocalizedTemplate{
+			Lang:        "en",
+			SMSTemplate: "\n{{.profileAlias}} & co: {{buildURL .url \"token\" .loginToken}}\n",
+		}, TemplateData{
+			"profileAlias": "<Alice>",
+			"url":          "https://example.org/study",
+			"loginToken":   "abc",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		expected := "<Alice> & co: https://example.org/study?token=abc"
+		if text != expected {
+			t.Errorf("unexpected result: %s", text)
+		}
+	})
+
+	t.Run("with syntax error", func(t *testing.T) {
+		_, err := ResolveSMSTemplate("test", types.LocalizedTemplate{Lang: "en", SMSTemplate: "{{.name"}, TemplateData{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestMatchSMSTranslation(t *testing.T) {
+	tDef := types.EmailTemplate{
+		MessageType:     "reminder",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", SMSTemplate: "Reminder"},
+			{Lang: "de"},
+			{Lang: "fr", SMSTemplate: "Rappel"},
+		},
+	}
+
+	t.Run("with SMS variant for language", func(t *testing.T) {
+		tr, usedDefault := MatchSMSTranslation("test", tDef, "fr")
+		if tr.Lang != "fr" || usedDefault {
+			t.Errorf("unexpected translation: %s (default: %v)", tr.Lang, usedDefault)
+		}
+	})
+
+	t.Run("with translation without SMS variant", func(t *testing.T) {
+		tr, usedDefault := MatchSMSTranslation("test", tDef, "de")
+		if tr.Lang != "en" || !usedDefault {
+			t.Errorf("unexpected translation: %s (default: %v)", tr.Lang, usedDefault)
+		}
+	})
+
+	t.Run("without SMS variant for default language", func(t *testing.T) {
+		tr, _ := MatchSMSTranslation("test", types.EmailTemplate{
+			DefaultLanguage: "en",
+			Translations:    []types.LocalizedTemplate{{Lang: "en"}},
+		}, "en")
+		if tr.SMSTemplate != "" {
+			t.Errorf("unexpected translation: %v", tr)
+		}
+	})
+}
+
+func TestCheckAllTranslationsParsableWithSMS(t *testing.T) {
+	tDef := types.EmailTemplate{
+		MessageType:     "reminder",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{
+				Lang:        "en",
+				TemplateDef: base64.StdEncoding.EncodeToString([]byte("<p>Reminder</p>")),
+				SMSTemplate: "Reminder {{.name",
+			},
+		},
+	}
+	if err := CheckAllTranslationsParsable("test", tDef, nil); err == nil {
+		t.Error("should return an error for an invalid SMS variant")
+	}
+
+	tDef.Translations[0].SMSTemplate = "Reminder {{.name}}"
+	if err := CheckAllTranslationsParsable("test", tDef, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResolvePushTemplate(t *testing.T) {
+	t.Run("without push variant", func(t *testing.T) {
+		_, err := ResolvePushTemplate("test", types.LocalizedTemplate{Lang: "en", SMSTemplate: "Reminder"}, TemplateData{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("with push variant", func(t *testing.T) {
+		push, err := ResolvePushTemplate("test", types.LocalizedTemplate{
+			Lang:         "en",
+			Subject:      "Weekly survey",
+			PushTemplate: "{{plural .count \"One survey is\" \"Surveys are\"}} waiting",
+		}, TemplateData{"count": "2"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if push.Title != "Weekly survey" || push.Body != "Surveys are waiting" {
+			t.Errorf("unexpected result: %+v", push)
+		}
+	})
+}
+
+func TestMatchPushTranslation(t *testing.T) {
+	tDef := types.EmailTemplate{
+		MessageType:     "reminder",
+		DefaultLanguage: "en",
+		Translations: []types.LocalizedTemplate{
+			{Lang: "en", PushTemplate: "Reminder"},
+			{Lang: "de", SMSTemplate: "Erinnerung"},
+		},
+	}
+	tr, usedDefault := MatchPushTranslation("test", tDef, "de")
+	if tr.Lang != "en" || !usedDefault {
+		t.Errorf("unexpected translation: %s (default: %v)", tr.Lang, usedDefault)
+	}
+}
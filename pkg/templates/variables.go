@@ -0,0 +1,243 @@
+package templates
+
+import (
+	"errors"
+	"html/template"
+	"sort"
+	"strings"
+	"text/template/parse"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+// variables set for every message, in addition to the global template constants
+var commonTemplateVariables = []string{"language"}
+
+// variables the services provide as content infos per message type
+var messageTypeVariables = map[string][]string{
+	// sent by the user management service
+	constants.EMAIL_TYPE_REGISTRATION:           {"token"},
+	constants.EMAIL_TYPE_INVITATION:             {"token"},
+	constants.EMAIL_TYPE_VERIFY_EMAIL:           {"token"},
+	constants.EMAIL_TYPE_AUTH_VERIFICATION_CODE: {"verificationCode"},
+	constants.EMAIL_TYPE_PASSWORD_RESET:         {"token", "validUntil"},
+	constants.EMAIL_TYPE_PASSWORD_CHANGED:       {},
+	constants.EMAIL_TYPE_ACCOUNT_ID_CHANGED:     {"restoreToken", "validUntil", "newEmail"},
+	constants.EMAIL_TYPE_ACCOUNT_DELETED:        {},
+	// generated by the bulk messages
+	constants.EMAIL_TYPE_WEEKLY:         {"loginToken", "studyKey"},
+	constants.EMAIL_TYPE_STUDY_REMINDER: {"loginToken", "studyKey"},
+	constants.EMAIL_TYPE_NEWSLETTER:     {"unsubscribeToken", "loginToken", "studyKey"},
+	// pending participant messages of a user combined into one message
+	types.EMAIL_TYPE_PARTICIPANT_MESSAGES_BATCH: {"loginToken", "studyKey", "messageCount", "messages", "profiles"},
+	// researcher notifications collected for a digest
+	types.EMAIL_TYPE_NOTIFICATION_DIGEST: {"studyKey", "digestMode", "entryCount", "entries"},
+}
+
+// variables of participant messages and researcher notifications, which use study defined message types
+var studyMessageVariables = []string{"loginToken", "studyKey", "profileAlias", "profileId", "participantID"}
+
+// KnownTemplateVariables returns the variables a template of the message type can use. For message types
+// defined by a study, ok is false unless the template declares its own variables (e.g. the payload keys).
+func KnownTemplateVariables(tDef types.EmailTemplate, globalConstants map[string]string) (variables map[string]bool, ok bool) {
+	typeVariables, ok := messageTypeVariables[tDef.MessageType]
+	if !ok {
+		if len(tDef.Variables) == 0 {
+			return nil, false
+		}
+		typeVariables = studyMessageVariables
+	}
+
+	variables = map[string]bool{}
+	for _, lists := range [][]string{commonTemplateVariables, typeVariables, tDef.Variables} {
+		for _, v := range lists {
+			variables[v] = true
+		}
+	}
+	for k := range globalConstants {
+		variables[k] = true
+	}
+	return variables, true
+}
+
+// ReferencedVariables walks the parse tree of the template and returns the top level fields used, e.g. "loginToken"
+// for {{.loginToken}}, {{$.loginToken}} or {{index . "loginToken"}}. Fields inside range and with blocks refer to
+// another value and are ignored.
+func ReferencedVariables(tempName string, templateDef string) ([]string, error) {
+	tmpl, err := newTemplate(tempName).Parse(templateDef)
+	if err != nil {
+		return nil, err
+	}
+
+	found := referencedRootKeys(templateTrees(tmpl))
+	variables := make([]string, 0, len(found))
+	for v := range found {
+		variables = append(variables, v)
+	}
+	sort.Strings(variables)
+	return variables, nil
+}
+
+// templateTrees returns the parse trees of the template and the templates associated with it, e.g. the partials
+func templateTrees(tmpl *template.Template) []*parse.Tree {
+	trees := []*parse.Tree{}
+	for _, t := range tmpl.Templates() {
+		trees = append(trees, t.Tree)
+	}
+	return trees
+}
+
+// referencedRootKeys returns the top level keys of the data the trees reference
+func referencedRootKeys(trees []*parse.Tree) map[string]bool {
+	found := map[string]bool{}
+	for _, tree := range trees {
+		if tree == nil || tree.Root == nil {
+			continue
+		}
+		walkTemplateNode(tree.Root, true, found)
+	}
+	return found
+}
+
+func walkTemplateNode(node parse.Node, dotIsRoot bool, found map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			walkTemplateNode(c, dotIsRoot, found)
+		}
+	case *parse.ActionNode:
+		walkTemplateNode(n.Pipe, dotIsRoot, found)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Cmds {
+			walkTemplateNode(c, dotIsRoot, found)
+		}
+	case *parse.CommandNode:
+		if key, ok := indexedRootKey(n, dotIsRoot); ok {
+			found[key] = true
+		}
+		for _, a := range n.Args {
+			walkTemplateNode(a, dotIsRoot, found)
+		}
+	case *parse.ChainNode:
+		walkTemplateNode(n.Node, dotIsRoot, found)
+	case *parse.FieldNode:
+		if dotIsRoot && len(n.Ident) > 0 {
+			found[n.Ident[0]] = true
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			found[n.Ident[1]] = true
+		}
+	case *parse.IfNode:
+		walkTemplateNode(n.Pipe, dotIsRoot, found)
+		walkTemplateNode(n.List, dotIsRoot, found)
+		walkTemplateNode(n.ElseList, dotIsRoot, found)
+	case *parse.RangeNode:
+		walkTemplateNode(n.Pipe, dotIsRoot, found)
+		walkTemplateNode(n.List, false, found)
+		walkTemplateNode(n.ElseList, dotIsRoot, found)
+	case *parse.WithNode:
+		walkTemplateNode(n.Pipe, dotIsRoot, found)
+		walkTemplateNode(n.List, false, found)
+		walkTemplateNode(n.ElseList, dotIsRoot, found)
+	case *parse.TemplateNode:
+		walkTemplateNode(n.Pipe, dotIsRoot, found)
+	}
+}
+
+// indexedRootKey returns the key of commands like {{index . "loginToken"}} or {{index $ "loginToken"}}
+func indexedRootKey(n *parse.CommandNode, dotIsRoot bool) (string, bool) {
+	if len(n.Args) < 3 {
+		return "", false
+	}
+	if ident, ok := n.Args[0].(*parse.IdentifierNode); !ok || ident.Ident != "index" {
+		return "", false
+	}
+	switch m := n.Args[1].(type) {
+	case *parse.DotNode:
+		if !dotIsRoot {
+			return "", false
+		}
+	case *parse.VariableNode:
+		if len(m.Ident) != 1 || m.Ident[0] != "$" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	key, ok := n.Args[2].(*parse.StringNode)
+	if !ok {
+		return "", false
+	}
+	return key.Text, true
+}
+
+// CheckTemplateVariables returns an error if a translation, its layout or a partial it includes references a variable
+// that is not provided for the message type of the template. Templates of study defined message types are only checked
+// if they declare variables.
+func CheckTemplateVariables(instanceID string, tDef types.EmailTemplate, partials []types.TemplatePartial) error {
+	known, ok := KnownTemplateVariables(tDef, LoadGlobalEmailTemplateConstants())
+	if !ok {
+		return nil
+	}
+
+	for _, templ := range tDef.Translations {
+		decodedTemplate, err := DecodeTemplateDef(templ)
+		if err != nil {
+			return err
+		}
+		used, err := ReferencedVariables(tDef.MessageType+templ.Lang, decodedTemplate)
+		if err != nil {
+			return err
+		}
+		for _, def := range []string{templ.SMSTemplate, templ.PushTemplate} {
+			if def == "" {
+				continue
+			}
+			usedInVariant, err := ReferencedVariables(tDef.MessageType+templ.Lang, def)
+			if err != nil {
+				return err
+			}
+			used = append(used, usedInVariant...)
+		}
+		if unknown := unknownVariables(used, known); len(unknown) > 0 {
+			return errors.New("unknown variables in template for `" + templ.Lang + "`: " + strings.Join(unknown, ", "))
+		}
+
+		// the layout and the partials are executed with the same data
+		shared, err := NewSharedTemplates(instanceID, partials, tDef.Layout, templ.Lang)
+		if err != nil {
+			return err
+		}
+		for _, name := range shared.usedPartials(decodedTemplate) {
+			usedInPartial, err := ReferencedVariables(name, shared.Partials[name])
+			if err != nil {
+				return err
+			}
+			if unknown := unknownVariables(usedInPartial, known); len(unknown) > 0 {
+				return errors.New("unknown variables in partial `" + name + "` for `" + templ.Lang + "`: " + strings.Join(unknown, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// unknownVariables returns the used variables that are not known, each once
+func unknownVariables(used []string, known map[string]bool) []string {
+	unknown := []string{}
+	reported := map[string]bool{}
+	for _, v := range used {
+		if !known[v] && !reported[v] {
+			unknown = append(unknown, v)
+			reported[v] = true
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,153 @@
+package templates
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+func TestReferencedVariables(t *testing.T) {
+	t.Run("with invalid template", func(t *testing.T) {
+		_, err := ReferencedVariables("test", "{{ .a ")
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("with nested blocks", func(t *testing.T) {
+		vars, err := ReferencedVariables("test", `{{.a}}{{if eq .b "x"}}{{.c}}{{else}}{{$.d}}{{end}}{{with .e}}{{.ignored}}{{$.f}}{{end}}{{range .g}}{{.ignored}}{{end}}{{define "sub"}}{{.h}}{{end}}{{index . "i"}}{{with .e}}{{index . "ignored"}}{{index $ "j"}}{{end}}`)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		expected := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+		if len(vars) != len(expected) {
+			t.Errorf("unexpected variables: %v", vars)
+			return
+		}
+		for i, v := range expected {
+			if vars[i] != v {
+				t.Errorf("unexpected variables: %v", vars)
+				return
+			}
+		}
+	})
+}
+
+func TestCheckTemplateVariables(t *testing.T) {
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+
+	t.Run("known message type with valid variables", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "password-reset",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`{{.token}} {{index . "validUntil"}} {{.language}}`)},
+			},
+		}, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("known message type with misspelled variable", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "study-reminder",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode("{{.loginToken}}")},
+				{Lang: "de", TemplateDef: encode("{{.logintoken}}")},
+			},
+		}, nil)
+		if err == nil || err.Error() != "unknown variables in template for `de`: logintoken" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("declared variable", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "newsletter",
+			Variables:   []string{"campaign"},
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode("{{.campaign}} {{.unsubscribeToken}}")},
+			},
+		}, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("study message type without declared variables", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "custom-study-message",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode("{{.anything}}")},
+			},
+		}, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("study message type with declared payload keys", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "custom-study-message",
+			Variables:   []string{"surveyKey"},
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode("{{.profileAlias}} {{.surveyKey}} {{.surveykey}}")},
+			},
+		}, nil)
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	partials := []types.TemplatePartial{
+		{Name: "layout", DefaultLanguage: "en", Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<div>{{template "content" .}}{{template "footer" .}}</div>`)},
+		}},
+		{Name: "footer", DefaultLanguage: "en", Translations: []types.LocalizedTemplate{
+			{Lang: "en", TemplateDef: encode(`<p>{{.unsubscribeToken}}</p>`)},
+			{Lang: "de", TemplateDef: encode(`<p>{{.unsubscribetoken}}</p>`)},
+		}},
+	}
+
+	t.Run("with valid layout and partials", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "newsletter",
+			Layout:      "layout",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`{{.loginToken}}`)},
+			},
+		}, partials)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with misspelled variable in a partial of the layout", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "newsletter",
+			Layout:      "layout",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "de", TemplateDef: encode(`{{.loginToken}}`)},
+			},
+		}, partials)
+		if err == nil || err.Error() != "unknown variables in partial `footer` for `de`: unsubscribetoken" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with partial using a variable unknown for the message type", func(t *testing.T) {
+		err := CheckTemplateVariables("test-instance", types.EmailTemplate{
+			MessageType: "password-reset",
+			Translations: []types.LocalizedTemplate{
+				{Lang: "en", TemplateDef: encode(`{{.token}} {{template "footer" .}}`)},
+			},
+		}, partials)
+		if err == nil || err.Error() != "unknown variables in partial `footer` for `en`: unsubscribeToken" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
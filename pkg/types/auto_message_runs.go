@@ -0,0 +1,55 @@
+package types
+
+import (
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AutoMessageRun records one execution of an auto message schedule
+type AutoMessageRun struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	AutoMessageID string             `bson:"autoMessageId"`
+	Label         string             `bson:"label"`
+	Type          string             `bson:"type"`
+	MessageType   string             `bson:"messageType"`
+	StartedAt     int64              `bson:"startedAt"`
+	FinishedAt    int64              `bson:"finishedAt"`
+	Generated     int                `bson:"generated"`
+	Failed        int                `bson:"failed"`
+	Skipped       int                `bson:"skipped"`
+	// messages generated in the default language, since the recipient's language was not available
+	LanguageFallbacks int    `bson:"languageFallbacks"`
+	Capped            int    `bson:"capped"` // emails deferred or dropped by the frequency cap
+	Error             string `bson:"error,omitempty"`
+}
+
+// Finish copies the final counter values and the error (if any) into the run
+func (obj *AutoMessageRun) Finish(counters MessageCounter, err error, finishedAt int64) {
+	obj.FinishedAt = finishedAt
+	obj.Generated = counters.Success
+	obj.Failed = counters.Failed
+	obj.Skipped = counters.Skipped
+	obj.LanguageFallbacks = counters.LanguageFallbacks
+	obj.Capped = counters.Capped
+	if err != nil {
+		obj.Error = err.Error()
+	}
+}
+
+func (obj AutoMessageRun) ToAPI() *api.AutoMessageRun {
+	return &api.AutoMessageRun{
+		Id:                obj.ID.Hex(),
+		AutoMessageId:     obj.AutoMessageID,
+		Label:             obj.Label,
+		Type:              obj.Type,
+		MessageType:       obj.MessageType,
+		StartedAt:         obj.StartedAt,
+		FinishedAt:        obj.FinishedAt,
+		Generated:         int32(obj.Generated),
+		Failed:            int32(obj.Failed),
+		Skipped:           int32(obj.Skipped),
+		LanguageFallbacks: int32(obj.LanguageFallbacks),
+		Capped:            int32(obj.Capped),
+		Error:             obj.Error,
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+
 	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -53,3 +55,17 @@ func (obj *AutoMessage) ToAPI() *api.AutoMessage {
 		Until:     obj.Until,
 	}
 }
+
+// CheckUntil returns an error if the termination date is set and is in the past or before the next time
+func (obj AutoMessage) CheckUntil(now int64) error {
+	if obj.Until <= 0 {
+		return nil
+	}
+	if obj.Until < now {
+		return errors.New("invalid termination date of auto message schedule, is in past")
+	}
+	if obj.Until < obj.NextTime {
+		return errors.New("invalid termination date of auto message schedule, earlier than start date")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ChannelPreference is the channel a user prefers to receive messages on. It changes the order of the routing rule of
+// a template if the rule contains the channel, the channels the template can be sent over are not changed.
+type ChannelPreference struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    string             `bson:"userID"`
+	Channel   string             `bson:"channel"`
+	UpdatedAt int64              `bson:"updatedAt"`
+}
+
+func ChannelPreferenceFromAPI(obj *api.ChannelPreference, userID string) ChannelPreference {
+	if obj == nil {
+		return ChannelPreference{}
+	}
+	return ChannelPreference{
+		UserID:  userID,
+		Channel: obj.Channel,
+	}
+}
+
+func (obj ChannelPreference) ToAPI() *api.ChannelPreference {
+	return &api.ChannelPreference{
+		Channel: obj.Channel,
+	}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"reflect"
+
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EmailTemplateVersion is an immutable record of an email template, written each time the template is saved
+type EmailTemplateVersion struct {
+	ID          primitive.ObjectID   `bson:"_id,omitempty"`
+	MessageType string               `bson:"messageType"`
+	StudyKey    string               `bson:"studyKey,omitempty"`
+	Version     int                  `bson:"version"`
+	Author      string               `bson:"author"` // user id, empty for templates saved before versioning
+	CreatedAt   int64                `bson:"createdAt"`
+	Changes     EmailTemplateChanges `bson:"changes"`
+	Template    EmailTemplate        `bson:"template"`
+}
+
+// EmailTemplateChanges describes the difference to the previous version of a template
+type EmailTemplateChanges struct {
+	AddedLanguages         []string `bson:"addedLanguages,omitempty"`
+	RemovedLanguages       []string `bson:"removedLanguages,omitempty"`
+	ChangedLanguages       []string `bson:"changedLanguages,omitempty"`
+	DefaultLanguageChanged bool     `bson:"defaultLanguageChanged,omitempty"`
+	HeaderOverridesChanged bool     `bson:"headerOverridesChanged,omitempty"`
+	VariablesChanged       bool     `bson:"variablesChanged,omitempty"`
+	LayoutChanged          bool     `bson:"layoutChanged,omitempty"`
+	InlineCSSChanged       bool     `bson:"inlineCSSChanged,omitempty"`
+	ChannelsChanged        bool     `bson:"channelsChanged,omitempty"`
+	RollbackOf             int      `bson:"rollbackOf,omitempty"`
+}
+
+// CompareEmailTemplates lists the changes from prev to next. Fields added to EmailTemplate have to be compared here,
+// otherwise versions changing only them are recorded without changes.
+func CompareEmailTemplates(prev EmailTemplate, next EmailTemplate) EmailTemplateChanges {
+	changes := EmailTemplateChanges{
+		AddedLanguages:         []string{},
+		RemovedLanguages:       []string{},
+		ChangedLanguages:       []string{},
+		DefaultLanguageChanged: prev.DefaultLanguage != next.DefaultLanguage,
+		HeaderOverridesChanged: !reflect.DeepEqual(prev.HeaderOverrides, next.HeaderOverrides),
+		VariablesChanged:       !reflect.DeepEqual(prev.Variables, next.Variables),
+		LayoutChanged:          prev.Layout != next.Layout,
+		InlineCSSChanged:       prev.InlineCSS != next.InlineCSS,
+		ChannelsChanged:        !reflect.DeepEqual(prev.Channels, next.Channels) && (len(prev.Channels) > 0 || len(next.Channels) > 0),
+	}
+
+	prevTranslations := map[string]LocalizedTemplate{}
+	for _, tr := range prev.Translations {
+		prevTranslations[tr.Lang] = tr
+	}
+	for _, tr := range next.Translations {
+		old, ok := prevTranslations[tr.Lang]
+		if !ok {
+			changes.AddedLanguages = append(changes.AddedLanguages, tr.Lang)
+		} else if old != tr {
+			changes.ChangedLanguages = append(changes.ChangedLanguages, tr.Lang)
+		}
+		delete(prevTranslations, tr.Lang)
+	}
+	for _, tr := range prev.Translations {
+		if _, ok := prevTranslations[tr.Lang]; ok {
+			changes.RemovedLanguages = append(changes.RemovedLanguages, tr.Lang)
+		}
+	}
+	return changes
+}
+
+func (obj EmailTemplateChanges) ToAPI() *api.EmailTemplateChanges {
+	return &api.EmailTemplateChanges{
+		AddedLanguages:         obj.AddedLanguages,
+		RemovedLanguages:       obj.RemovedLanguages,
+		ChangedLanguages:       obj.ChangedLanguages,
+		DefaultLanguageChanged: obj.DefaultLanguageChanged,
+		HeaderOverridesChanged: obj.HeaderOverridesChanged,
+		VariablesChanged:       obj.VariablesChanged,
+		LayoutChanged:          obj.LayoutChanged,
+		InlineCssChanged:       obj.InlineCSSChanged,
+		ChannelsChanged:        obj.ChannelsChanged,
+		RollbackOf:             int32(obj.RollbackOf),
+	}
+}
+
+// ToAPI converts a template version from DB format into the API format, the template itself is only included if withTemplate is true
+func (obj EmailTemplateVersion) ToAPI(withTemplate bool) *api.EmailTemplateVersion {
+	v := &api.EmailTemplateVersion{
+		Id:          obj.ID.Hex(),
+		MessageType: obj.MessageType,
+		StudyKey:    obj.StudyKey,
+		Version:     int32(obj.Version),
+		Author:      obj.Author,
+		CreatedAt:   obj.CreatedAt,
+		Changes:     obj.Changes.ToAPI(),
+	}
+	if withTemplate {
+		v.Template = obj.Template.ToAPI()
+	}
+	return v
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareEmailTemplates(t *testing.T) {
+	base := EmailTemplate{
+		MessageType:     "newsletter",
+		DefaultLanguage: "en",
+		Translations: []LocalizedTemplate{
+			{Lang: "en", Subject: "Hello", TemplateDef: "content"},
+			{Lang: "de", Subject: "Hallo", TemplateDef: "Inhalt"},
+		},
+		Layout:   "base-layout",
+		Channels: []string{"email"},
+	}
+
+	t.Run("without changes", func(t *testing.T) {
+		changes := CompareEmailTemplates(base, base)
+		if !reflect.DeepEqual(changes, EmailTemplateChanges{AddedLanguages: []string{}, RemovedLanguages: []string{}, ChangedLanguages: []string{}}) {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("with changed translations", func(t *testing.T) {
+		next := base
+		next.Translations = []LocalizedTemplate{
+			{Lang: "en", Subject: "Hello again", TemplateDef: "content"},
+			{Lang: "fr", Subject: "Bonjour", TemplateDef: "contenu"},
+		}
+		changes := CompareEmailTemplates(base, next)
+		if !reflect.DeepEqual(changes.AddedLanguages, []string{"fr"}) ||
+			!reflect.DeepEqual(changes.RemovedLanguages, []string{"de"}) ||
+			!reflect.DeepEqual(changes.ChangedLanguages, []string{"en"}) {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("with changed layout", func(t *testing.T) {
+		next := base
+		next.Layout = "other-layout"
+		changes := CompareEmailTemplates(base, next)
+		if !changes.LayoutChanged || changes.InlineCSSChanged || changes.ChannelsChanged {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("with changed inline css", func(t *testing.T) {
+		next := base
+		next.InlineCSS = true
+		changes := CompareEmailTemplates(base, next)
+		if !changes.InlineCSSChanged || changes.LayoutChanged || changes.ChannelsChanged {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("with changed channels", func(t *testing.T) {
+		next := base
+		next.Channels = []string{"sms", "email"}
+		changes := CompareEmailTemplates(base, next)
+		if !changes.ChannelsChanged || changes.LayoutChanged || changes.InlineCSSChanged {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("with empty instead of missing channels", func(t *testing.T) {
+		prev := base
+		prev.Channels = nil
+		next := base
+		next.Channels = []string{}
+		if CompareEmailTemplates(prev, next).ChannelsChanged {
+			t.Error("channels should be unchanged")
+		}
+	})
+}
@@ -6,6 +6,18 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	EMAIL_TEMPLATE_STATUS_DRAFT     = "draft"
+	EMAIL_TEMPLATE_STATUS_PUBLISHED = "published"
+
+	TEMPLATE_FORMAT_HTML     = "html"
+	TEMPLATE_FORMAT_MARKDOWN = "markdown"
+
+	// EMAIL_TYPE_PARTICIPANT_MESSAGES_BATCH is the message type of the template combining the pending participant
+	// messages of a user into one message
+	EMAIL_TYPE_PARTICIPANT_MESSAGES_BATCH = "participant-messages-batch"
+)
+
 type EmailTemplate struct {
 	ID              primitive.ObjectID  `bson:"_id,omitempty"`
 	MessageType     string              `bson:"messageType"` // e.g. 'registration','invitation'..), use constants EMAIL_TYPE_* in go-utils/pkg/constants
@@ -13,19 +25,52 @@ type EmailTemplate struct {
 	DefaultLanguage string              `bson:"defaultLanguage"`
 	HeaderOverrides *HeaderOverrides    `bson:"headerOverrides"`
 	Translations    []LocalizedTemplate `bson:"translations"`
+	Variables       []string            `bson:"variables,omitempty"` // additional variables the template may use, e.g. payload keys
+	Version         int                 `bson:"version,omitempty"`   // incremented on each save, see email-template-versions
+	Status          string              `bson:"status,omitempty"`    // draft or published, empty for templates saved before drafts were introduced (published)
+	Layout          string              `bson:"layout,omitempty"`    // name of the template partial the content is wrapped in
+	InlineCSS       bool                `bson:"inlineCSS,omitempty"` // inline the CSS rules of <style> blocks when rendering
+	Channels        []string            `bson:"channels,omitempty"`  // routing rule, channels in order of preference
 }
 
 type HeaderOverrides struct {
-	From      string   `bson:"from"`
-	Sender    string   `bson:"sender"`
-	ReplyTo   []string `bson:"replyTo"`
-	NoReplyTo bool     `bson:"noReplyTo"`
+	From      string   `bson:"from" yaml:"from,omitempty"`
+	Sender    string   `bson:"sender" yaml:"sender,omitempty"`
+	ReplyTo   []string `bson:"replyTo" yaml:"replyTo,omitempty"`
+	NoReplyTo bool     `bson:"noReplyTo" yaml:"noReplyTo,omitempty"`
 }
 
 type LocalizedTemplate struct {
-	Lang        string `bson:"languageCode"`
-	Subject     string `bson:"subject"`
-	TemplateDef string `bson:"templateDef"`
+	Lang         string `bson:"languageCode"`
+	Subject      string `bson:"subject"`
+	TemplateDef  string `bson:"templateDef"`
+	Format       string `bson:"format,omitempty"`       // html (default if empty) or markdown
+	SMSTemplate  string `bson:"smsTemplate,omitempty"`  // plain text variant for phone accounts, empty if not sent as SMS
+	PushTemplate string `bson:"pushTemplate,omitempty"` // short text of web push notifications, empty if not sent as push
+}
+
+func (obj LocalizedTemplate) IsMarkdown() bool {
+	return obj.Format == TEMPLATE_FORMAT_MARKDOWN
+}
+
+// HasSMSVariant returns true if any translation of the template can be sent as SMS
+func (obj EmailTemplate) HasSMSVariant() bool {
+	for _, tr := range obj.Translations {
+		if tr.SMSTemplate != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPushVariant returns true if any translation of the template can be sent as web push notification
+func (obj EmailTemplate) HasPushVariant() bool {
+	for _, tr := range obj.Translations {
+		if tr.PushTemplate != "" {
+			return true
+		}
+	}
+	return false
 }
 
 func HeaderOverridesFromAPI(obj *api.HeaderOverrides) *HeaderOverrides {
@@ -104,6 +149,12 @@ func EmailTemplateFromAPI(obj *api.EmailTemplate) EmailTemplate {
 		DefaultLanguage: obj.DefaultLanguage,
 		HeaderOverrides: HeaderOverridesFromAPI(obj.HeaderOverrides),
 		Translations:    translations,
+		Variables:       obj.Variables,
+		Version:         int(obj.Version),
+		Status:          obj.Status,
+		Layout:          obj.Layout,
+		InlineCSS:       obj.InlineCss,
+		Channels:        obj.Channels,
 	}
 }
 
@@ -120,25 +171,42 @@ func (obj EmailTemplate) ToAPI() *api.EmailTemplate {
 		DefaultLanguage: obj.DefaultLanguage,
 		HeaderOverrides: obj.HeaderOverrides.ToAPI(),
 		Translations:    translations,
+		Variables:       obj.Variables,
+		Version:         int32(obj.Version),
+		Status:          obj.Status,
+		Layout:          obj.Layout,
+		InlineCss:       obj.InlineCSS,
+		Channels:        obj.Channels,
 	}
 }
 
+// IsDraft returns true if the template is not published yet, templates without status are published
+func (obj EmailTemplate) IsDraft() bool {
+	return obj.Status == EMAIL_TEMPLATE_STATUS_DRAFT
+}
+
 func LocalizedTemplateFromAPI(obj *api.LocalizedTemplate) LocalizedTemplate {
 	if obj == nil {
 		return LocalizedTemplate{}
 	}
 	return LocalizedTemplate{
-		Lang:        obj.Lang,
-		Subject:     obj.Subject,
-		TemplateDef: obj.TemplateDef,
+		Lang:         obj.Lang,
+		Subject:      obj.Subject,
+		TemplateDef:  obj.TemplateDef,
+		Format:       obj.Format,
+		SMSTemplate:  obj.SmsTemplate,
+		PushTemplate: obj.PushTemplate,
 	}
 }
 
 // ToAPI converts a localized template from DB format into the API format
 func (obj LocalizedTemplate) ToAPI() *api.LocalizedTemplate {
 	return &api.LocalizedTemplate{
-		Lang:        obj.Lang,
-		Subject:     obj.Subject,
-		TemplateDef: obj.TemplateDef,
+		Lang:         obj.Lang,
+		Subject:      obj.Subject,
+		TemplateDef:  obj.TemplateDef,
+		Format:       obj.Format,
+		SmsTemplate:  obj.SMSTemplate,
+		PushTemplate: obj.PushTemplate,
 	}
 }
@@ -6,16 +6,16 @@ import (
 )
 
 type Expression struct {
-	Name       string          `bson:"name"`
-	ReturnType string          `bson:"returnType,omitempty"`
-	Data       []ExpressionArg `bson:"data,omitempty"`
+	Name       string          `bson:"name" yaml:"name"`
+	ReturnType string          `bson:"returnType,omitempty" yaml:"returnType,omitempty"`
+	Data       []ExpressionArg `bson:"data,omitempty" yaml:"data,omitempty"`
 }
 
 type ExpressionArg struct {
-	DType string      `bson:"dtype"`
-	Exp   *Expression `bson:"exp,omitempty"`
-	Str   string      `bson:"str,omitempty"`
-	Num   float64     `bson:"num,omitempty"`
+	DType string      `bson:"dtype" yaml:"dtype,omitempty"`
+	Exp   *Expression `bson:"exp,omitempty" yaml:"exp,omitempty"`
+	Str   string      `bson:"str,omitempty" yaml:"str,omitempty"`
+	Num   float64     `bson:"num,omitempty" yaml:"num,omitempty"`
 }
 
 func (e *ExpressionArg) ToAPI() *api.ExpressionArg {
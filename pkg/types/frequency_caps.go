@@ -0,0 +1,96 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	FREQUENCY_CAP_POLICY_DEFER = "defer"
+	FREQUENCY_CAP_POLICY_DROP  = "drop"
+
+	DEFAULT_FREQUENCY_CAP_PERIOD = 24 * 60 * 60
+)
+
+// TRANSACTIONAL_MESSAGE_TYPES are sent on actions of the user and are neither capped nor counted for the cap
+var TRANSACTIONAL_MESSAGE_TYPES = []string{
+	constants.EMAIL_TYPE_REGISTRATION,
+	constants.EMAIL_TYPE_INVITATION,
+	constants.EMAIL_TYPE_VERIFY_EMAIL,
+	constants.EMAIL_TYPE_AUTH_VERIFICATION_CODE,
+	constants.EMAIL_TYPE_PASSWORD_RESET,
+	constants.EMAIL_TYPE_PASSWORD_CHANGED,
+	constants.EMAIL_TYPE_ACCOUNT_ID_CHANGED,
+	constants.EMAIL_TYPE_ACCOUNT_DELETED,
+}
+
+// FrequencyCap limits the non-transactional emails per recipient of an instance within the period
+type FrequencyCap struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	MaxMessages int                `bson:"maxMessages"` // 0 disables the cap
+	Period      int64              `bson:"period"`      // in seconds
+	Policy      string             `bson:"policy"`
+}
+
+func FrequencyCapFromAPI(obj *api.FrequencyCap) FrequencyCap {
+	if obj == nil {
+		return FrequencyCap{}
+	}
+	c := FrequencyCap{
+		MaxMessages: int(obj.MaxMessages),
+		Period:      obj.Period,
+		Policy:      obj.Policy,
+	}
+	if c.Period <= 0 {
+		c.Period = DEFAULT_FREQUENCY_CAP_PERIOD
+	}
+	if c.Policy == "" {
+		c.Policy = FREQUENCY_CAP_POLICY_DEFER
+	}
+	return c
+}
+
+func (obj FrequencyCap) ToAPI() *api.FrequencyCap {
+	return &api.FrequencyCap{
+		MaxMessages: int32(obj.MaxMessages),
+		Period:      obj.Period,
+		Policy:      obj.Policy,
+	}
+}
+
+// IsFrequencyCapPolicy checks if the policy is supported, empty means defer
+func IsFrequencyCapPolicy(policy string) bool {
+	return policy == "" || policy == FREQUENCY_CAP_POLICY_DEFER || policy == FREQUENCY_CAP_POLICY_DROP
+}
+
+// Check decides if another email can be sent at now, given the times of the recipient's emails within the period.
+// If the cap is reached, sendAfter is the time the oldest email counting towards the cap leaves the period.
+func (obj FrequencyCap) Check(sentTimes []int64, now int64) (capped bool, sendAfter int64) {
+	if obj.MaxMessages <= 0 {
+		return false, 0
+	}
+	inPeriod := []int64{}
+	for _, t := range sentTimes {
+		if t > now-obj.Period {
+			inPeriod = append(inPeriod, t)
+		}
+	}
+	if len(inPeriod) < obj.MaxMessages {
+		return false, 0
+	}
+	sort.Slice(inPeriod, func(i, j int) bool { return inPeriod[i] < inPeriod[j] })
+	return true, inPeriod[len(inPeriod)-obj.MaxMessages] + obj.Period
+}
+
+// CappedMessage records an email deferred or dropped by the frequency cap
+type CappedMessage struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	MessageType string             `bson:"messageType"`
+	To          string             `bson:"to"`
+	Policy      string             `bson:"policy"`
+	SendAfter   int64              `bson:"sendAfter,omitempty"` // deferred emails only
+	CappedAt    int64              `bson:"cappedAt"`
+}
@@ -3,11 +3,16 @@ package types
 import "time"
 
 type MessageCounter struct {
-	Total     int
-	Failed    int
-	Success   int
-	StartTime int64
-	Duration  int64
+	Total             int
+	Failed            int
+	Success           int
+	Skipped           int
+	LanguageFallbacks int
+	Capped            int
+	StartTime         int64
+	Duration          int64
+
+	loggedFallbacks map[string]bool
 }
 
 func (mc *MessageCounter) IncreaseCounter(success bool) {
@@ -20,15 +25,49 @@ func (mc *MessageCounter) IncreaseCounter(success bool) {
 	mc.Duration = time.Now().Unix() - mc.StartTime
 }
 
+// IncreaseSkipped counts a recipient that was filtered out before a message was generated
+func (mc *MessageCounter) IncreaseSkipped() {
+	mc.Skipped += 1
+}
+
+// IncreaseLanguageFallbacks counts a message generated in the default language, since the user's was not available
+func (mc *MessageCounter) IncreaseLanguageFallbacks() {
+	mc.LanguageFallbacks += 1
+}
+
+// FirstLanguageFallback returns true the first time it is called with the key (e.g. template, variant and language)
+// in the run, so a missing translation is logged once and not for every message
+func (mc *MessageCounter) FirstLanguageFallback(key string) bool {
+	if mc == nil {
+		return true
+	}
+	if mc.loggedFallbacks == nil {
+		mc.loggedFallbacks = map[string]bool{}
+	}
+	if mc.loggedFallbacks[key] {
+		return false
+	}
+	mc.loggedFallbacks[key] = true
+	return true
+}
+
+// IncreaseCapped counts an email deferred or dropped by the frequency cap of the instance
+func (mc *MessageCounter) IncreaseCapped() {
+	mc.Capped += 1
+}
+
 func (mc *MessageCounter) Stop() {
 	mc.Duration = time.Now().Unix() - mc.StartTime
 }
 
 func InitMessageCounter() MessageCounter {
 	return MessageCounter{
-		Total:     0,
-		Failed:    0,
-		Success:   0,
-		StartTime: time.Now().Unix(),
+		Total:             0,
+		Failed:            0,
+		Success:           0,
+		Skipped:           0,
+		LanguageFallbacks: 0,
+		Capped:            0,
+		StartTime:         time.Now().Unix(),
 	}
 }
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	DIGEST_MODE_IMMEDIATE = "immediate"
+	DIGEST_MODE_HOURLY    = "hourly"
+	DIGEST_MODE_DAILY     = "daily"
+
+	// EMAIL_TYPE_NOTIFICATION_DIGEST is the message type of the template rendering the digests, per study or for
+	// all studies
+	EMAIL_TYPE_NOTIFICATION_DIGEST = "researcher-notification-digest"
+)
+
+// NotificationDigestSettings decides if the researcher notifications of a study are sent by email one by one, or
+// collected and sent as digest
+type NotificationDigestSettings struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	StudyKey     string             `bson:"studyKey"`
+	Mode         string             `bson:"mode"`
+	LastDigestAt int64              `bson:"lastDigestAt"`
+}
+
+// DigestInterval is the time between two digests of the mode, 0 for immediate (or unknown) modes
+func DigestInterval(mode string) time.Duration {
+	switch mode {
+	case DIGEST_MODE_HOURLY:
+		return time.Hour
+	case DIGEST_MODE_DAILY:
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+// IsDigestMode checks if the mode is one of the supported modes, an empty mode means immediate
+func IsDigestMode(mode string) bool {
+	return mode == "" || mode == DIGEST_MODE_IMMEDIATE || DigestInterval(mode) > 0
+}
+
+// IsDue returns true if the last digest is at least one interval ago. Immediate studies are always due, to send
+// the entries left from a digest mode.
+func (obj NotificationDigestSettings) IsDue(now time.Time) bool {
+	return now.Sub(time.Unix(obj.LastDigestAt, 0)) >= DigestInterval(obj.Mode)
+}
+
+func NotificationDigestSettingsFromAPI(obj *api.NotificationDigestSettings) NotificationDigestSettings {
+	if obj == nil {
+		return NotificationDigestSettings{}
+	}
+	mode := obj.Mode
+	if mode == "" {
+		mode = DIGEST_MODE_IMMEDIATE
+	}
+	return NotificationDigestSettings{
+		StudyKey: obj.StudyKey,
+		Mode:     mode,
+	}
+}
+
+func (obj NotificationDigestSettings) ToAPI() *api.NotificationDigestSettings {
+	return &api.NotificationDigestSettings{
+		StudyKey:     obj.StudyKey,
+		Mode:         obj.Mode,
+		LastDigestAt: obj.LastDigestAt,
+	}
+}
+
+// NotificationDigestEntry is a researcher notification collected for the next digest of one recipient
+type NotificationDigestEntry struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	StudyKey       string             `bson:"studyKey"`
+	Recipient      string             `bson:"recipient"`
+	MessageType    string             `bson:"messageType"`
+	NotificationID string             `bson:"notificationID"`
+	ParticipantID  string             `bson:"participantID,omitempty"`
+	Payload        map[string]string  `bson:"payload,omitempty"`
+	CreatedAt      int64              `bson:"createdAt"`
+}
@@ -9,7 +9,26 @@ type OutgoingEmail struct {
 	Subject         string             `bson:"subject"`
 	HeaderOverrides *HeaderOverrides   `bson:"headers"`
 	Content         string             `bson:"content"`
+	TextContent     string             `bson:"textContent,omitempty"` // plain-text part, e.g. of markdown templates
 	AddedAt         int64              `bson:"addedAt"`
-	HighPrio        bool               `bson:"highPrio"`
+	HighPrio        bool               `bson:"highPrio"` // kept in sync with Priority for older schedulers
 	LastSendAttempt int64              `bson:"lastSendAttempt"`
+	TemplateVersion int                `bson:"templateVersion,omitempty"`
+	Channel         string             `bson:"channel,omitempty"`   // channel chosen by the routing rule of the template, set by the bulk generators
+	SendAfter       int64              `bson:"sendAfter,omitempty"` // deferred by the frequency cap until then
+	Priority        string             `bson:"priority,omitempty"`  // empty for emails queued before the priorities, see GetPriority
+}
+
+// GetPriority returns the priority of the email, derived from HighPrio for emails queued without priority
+func (email OutgoingEmail) GetPriority() string {
+	if email.Priority == "" {
+		return PriorityFromHighPrio(email.HighPrio)
+	}
+	return email.Priority
+}
+
+// SetPriority sets the priority, and HighPrio accordingly
+func (email *OutgoingEmail) SetPriority(priority string) {
+	email.Priority = priority
+	email.HighPrio = IsHighPriority(priority)
 }
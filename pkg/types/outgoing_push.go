@@ -0,0 +1,17 @@
+package types
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// OutgoingPush is a web push notification for one subscription of the user
+type OutgoingPush struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	MessageType     string             `bson:"messageType"`
+	Subscription    PushSubscription   `bson:"subscription"`
+	Title           string             `bson:"title"`
+	Body            string             `bson:"body"`
+	AddedAt         int64              `bson:"addedAt"`
+	HighPrio        bool               `bson:"highPrio"`
+	LastSendAttempt int64              `bson:"lastSendAttempt"`
+	TemplateVersion int                `bson:"templateVersion,omitempty"`
+	Channel         string             `bson:"channel,omitempty"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+const (
+	// account types of the user management service, the account ID is the address on the channel
+	ACCOUNT_TYPE_EMAIL = "email"
+	ACCOUNT_TYPE_PHONE = "phone"
+)
+
+type OutgoingSMS struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	MessageType     string             `bson:"messageType"`
+	To              string             `bson:"to"` // phone number
+	Text            string             `bson:"text"`
+	AddedAt         int64              `bson:"addedAt"`
+	HighPrio        bool               `bson:"highPrio"`
+	LastSendAttempt int64              `bson:"lastSendAttempt"`
+	TemplateVersion int                `bson:"templateVersion,omitempty"`
+	Channel         string             `bson:"channel,omitempty"`
+}
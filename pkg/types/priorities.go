@@ -0,0 +1,50 @@
+package types
+
+import "github.com/influenzanet/go-utils/pkg/constants"
+
+// Priorities of outgoing emails, each fetched by its own loop of the message scheduler and mapped to an SMTP pool of
+// the email client service
+const (
+	PRIORITY_TRANSACTIONAL  = "transactional"
+	PRIORITY_STUDY_CRITICAL = "study-critical"
+	PRIORITY_REMINDER       = "reminder"
+	PRIORITY_NEWSLETTER     = "newsletter"
+)
+
+// PRIORITIES from the highest to the lowest
+var PRIORITIES = []string{
+	PRIORITY_TRANSACTIONAL,
+	PRIORITY_STUDY_CRITICAL,
+	PRIORITY_REMINDER,
+	PRIORITY_NEWSLETTER,
+}
+
+func IsPriority(priority string) bool {
+	for _, p := range PRIORITIES {
+		if p == priority {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHighPriority tells if the priority counts as high prio for services that only know high and low prio
+func IsHighPriority(priority string) bool {
+	return priority == PRIORITY_TRANSACTIONAL || priority == PRIORITY_STUDY_CRITICAL
+}
+
+// PriorityFromHighPrio is the priority of requests and queued emails without priority
+func PriorityFromHighPrio(highPrio bool) string {
+	if highPrio {
+		return PRIORITY_TRANSACTIONAL
+	}
+	return PRIORITY_REMINDER
+}
+
+// BulkMessagePriority is the priority of auto messages and bulk sends of the message type
+func BulkMessagePriority(messageType string) string {
+	if messageType == constants.EMAIL_TYPE_NEWSLETTER {
+		return PRIORITY_NEWSLETTER
+	}
+	return PRIORITY_REMINDER
+}
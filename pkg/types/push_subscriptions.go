@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MAX_PUSH_SUBSCRIPTIONS_PER_USER limits the browsers a user is subscribed with, the oldest subscriptions are replaced
+const MAX_PUSH_SUBSCRIPTIONS_PER_USER = 10
+
+// PushSubscription is the web push subscription of a browser the user opted in to notifications with
+type PushSubscription struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    string             `bson:"userID"`
+	Endpoint  string             `bson:"endpoint"`
+	P256dh    string             `bson:"p256dh"` // public key of the browser, base64url
+	Auth      string             `bson:"auth"`   // authentication secret, base64url
+	CreatedAt int64              `bson:"createdAt"`
+}
+
+func PushSubscriptionFromAPI(obj *api.PushSubscription, userID string) PushSubscription {
+	if obj == nil {
+		return PushSubscription{}
+	}
+	return PushSubscription{
+		UserID:   userID,
+		Endpoint: obj.Endpoint,
+		P256dh:   obj.P256Dh,
+		Auth:     obj.Auth,
+	}
+}
+
+// CheckPushEndpoint returns an error if the endpoint is not an https URL of a public host. The endpoint is posted to by
+// the message scheduler, so it must not point to internal services.
+func CheckPushEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return errors.New("invalid push endpoint")
+	}
+	if !isPublicHost(u.Hostname()) {
+		return errors.New("invalid push endpoint host")
+	}
+	return nil
+}
+
+// isPublicHost returns false for localhost and for loopback, private, link-local, unspecified and multicast IPs. Host
+// names are not resolved.
+func isPublicHost(hostname string) bool {
+	host := strings.ToLower(strings.TrimSuffix(hostname, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicIP(ip)
+	}
+	return true
+}
+
+// IsPublicIP returns false for loopback, private, link-local, unspecified and multicast IPs, e.g. to check the address
+// a host name resolved to before connecting
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+}
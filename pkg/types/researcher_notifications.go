@@ -0,0 +1,12 @@
+package types
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// HandledNotificationRecipient records that a researcher notification was queued (or added to the digest) for one of
+// its recipients, so that a notification kept for retrying is not sent to this recipient again
+type HandledNotificationRecipient struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	NotificationID string             `bson:"notificationID"`
+	Recipient      string             `bson:"recipient"` // email address or webhook:<name>, as in the notification
+	HandledAt      int64              `bson:"handledAt"`
+}
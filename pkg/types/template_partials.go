@@ -0,0 +1,45 @@
+package types
+
+import (
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TemplatePartial is a named block shared by the email templates of an instance, e.g. a header, footer or layout
+type TemplatePartial struct {
+	ID              primitive.ObjectID  `bson:"_id,omitempty"`
+	Name            string              `bson:"name"`
+	DefaultLanguage string              `bson:"defaultLanguage"`
+	Translations    []LocalizedTemplate `bson:"translations"` // subject is not used
+}
+
+func TemplatePartialFromAPI(obj *api.TemplatePartial) TemplatePartial {
+	if obj == nil {
+		return TemplatePartial{}
+	}
+	_id, _ := primitive.ObjectIDFromHex(obj.Id)
+	translations := make([]LocalizedTemplate, len(obj.Translations))
+	for i, t := range obj.Translations {
+		translations[i] = LocalizedTemplateFromAPI(t)
+	}
+	return TemplatePartial{
+		ID:              _id,
+		Name:            obj.Name,
+		DefaultLanguage: obj.DefaultLanguage,
+		Translations:    translations,
+	}
+}
+
+// ToAPI converts a template partial from DB format into the API format
+func (obj TemplatePartial) ToAPI() *api.TemplatePartial {
+	translations := make([]*api.LocalizedTemplate, len(obj.Translations))
+	for i, t := range obj.Translations {
+		translations[i] = t.ToAPI()
+	}
+	return &api.TemplatePartial{
+		Id:              obj.ID.Hex(),
+		Name:            obj.Name,
+		DefaultLanguage: obj.DefaultLanguage,
+		Translations:    translations,
+	}
+}
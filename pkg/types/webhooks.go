@@ -0,0 +1,124 @@
+package types
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	api "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WEBHOOK_RECIPIENT_PREFIX marks recipients of researcher notifications that are webhook targets instead of email
+// addresses, e.g. "webhook:redcap"
+const WEBHOOK_RECIPIENT_PREFIX = "webhook:"
+
+// WebhookTarget is an HTTP endpoint researcher notifications are posted to
+type WebhookTarget struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Name      string             `bson:"name"`
+	URL       string             `bson:"url"`
+	Secret    string             `bson:"secret"`
+	StudyKey  string             `bson:"studyKey,omitempty"` // if set, only notifications of this study are posted
+	CreatedAt int64              `bson:"createdAt"`
+}
+
+// WebhookTargetName returns the name of the webhook target, if the recipient is one
+func WebhookTargetName(recipient string) (string, bool) {
+	if !strings.HasPrefix(recipient, WEBHOOK_RECIPIENT_PREFIX) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(recipient, WEBHOOK_RECIPIENT_PREFIX)), true
+}
+
+// CheckWebhookURL returns an error if the URL is not an https URL of a public host, like CheckPushEndpoint. http is
+// accepted if allowHTTP is set, for local testing.
+func CheckWebhookURL(rawURL string, allowHTTP bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && !(allowHTTP && u.Scheme == "http")) || u.Hostname() == "" || u.User != nil {
+		return errors.New("invalid webhook url")
+	}
+	if !isPublicHost(u.Hostname()) {
+		return errors.New("invalid webhook url host")
+	}
+	return nil
+}
+
+func WebhookTargetFromAPI(obj *api.WebhookTarget) WebhookTarget {
+	if obj == nil {
+		return WebhookTarget{}
+	}
+	_id, _ := primitive.ObjectIDFromHex(obj.Id)
+	return WebhookTarget{
+		ID:       _id,
+		Name:     obj.Name,
+		URL:      obj.Url,
+		Secret:   obj.Secret,
+		StudyKey: obj.StudyKey,
+	}
+}
+
+// ToAPI converts a webhook target from DB format into the API format, without the secret
+func (obj WebhookTarget) ToAPI() *api.WebhookTarget {
+	return &api.WebhookTarget{
+		Id:       obj.ID.Hex(),
+		Name:     obj.Name,
+		Url:      obj.URL,
+		StudyKey: obj.StudyKey,
+	}
+}
+
+// WebhookPayload is the JSON body posted to webhook targets, with the fields of the study service's notification
+type WebhookPayload struct {
+	ID            string            `json:"id"`
+	Type          string            `json:"type"`
+	InstanceID    string            `json:"instanceID"`
+	StudyKey      string            `json:"studyKey"`
+	ParticipantID string            `json:"participantID,omitempty"`
+	Payload       map[string]string `json:"payload,omitempty"`
+	CreatedAt     int64             `json:"createdAt"`
+}
+
+// OutgoingWebhook is a notification queued for a webhook target. The URL and secret of the target are looked up
+// when it is sent.
+type OutgoingWebhook struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	TargetName      string             `bson:"targetName"`
+	MessageType     string             `bson:"messageType"`
+	StudyKey        string             `bson:"studyKey"`
+	NotificationID  string             `bson:"notificationID"`
+	Payload         string             `bson:"payload"` // JSON of WebhookPayload
+	AddedAt         int64              `bson:"addedAt"`
+	Attempts        int                `bson:"attempts"`
+	NextAttempt     int64              `bson:"nextAttempt"` // no retry before this time
+	LastSendAttempt int64              `bson:"lastSendAttempt"`
+}
+
+// WebhookDelivery records one attempt to post a notification to a webhook target
+type WebhookDelivery struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	TargetName     string             `bson:"targetName"`
+	MessageType    string             `bson:"messageType"`
+	StudyKey       string             `bson:"studyKey"`
+	NotificationID string             `bson:"notificationID"`
+	Attempt        int                `bson:"attempt"`
+	StatusCode     int                `bson:"statusCode,omitempty"` // 0 if there was no response
+	Error          string             `bson:"error,omitempty"`
+	Delivered      bool               `bson:"delivered"`
+	SentAt         int64              `bson:"sentAt"`
+}
+
+func (obj WebhookDelivery) ToAPI() *api.WebhookDelivery {
+	return &api.WebhookDelivery{
+		Id:             obj.ID.Hex(),
+		TargetName:     obj.TargetName,
+		MessageType:    obj.MessageType,
+		StudyKey:       obj.StudyKey,
+		NotificationId: obj.NotificationID,
+		Attempt:        int32(obj.Attempt),
+		StatusCode:     int32(obj.StatusCode),
+		Error:          obj.Error,
+		Delivered:      obj.Delivered,
+		SentAt:         obj.SentAt,
+	}
+}
@@ -0,0 +1,129 @@
+package webhook_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/influenzanet/messaging-service/pkg/types"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	// MAX_ATTEMPTS is the number of attempts to deliver a notification, before it is dropped
+	MAX_ATTEMPTS = 5
+	// first retry after a minute, then the delay doubles with each attempt
+	retryBaseDelay = time.Minute
+
+	HEADER_TIMESTAMP = "X-Webhook-Timestamp"
+	HEADER_SIGNATURE = "X-Webhook-Signature"
+)
+
+// WebhookClientConfig configures the HTTP requests to webhook targets
+type WebhookClientConfig struct {
+	Timeout   time.Duration
+	AllowHTTP bool // for local testing, target URLs have to use https otherwise
+
+	allowPrivateHosts bool // for tests with a local server
+}
+
+// StatusError is returned if the target responded with a status other than 2xx
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("webhook target responded with %s: %s", e.Status, e.Body)
+}
+
+// WebhookClient posts signed JSON payloads to webhook targets
+type WebhookClient struct {
+	httpClient *http.Client
+}
+
+func NewWebhookClient(config WebhookClientConfig) *WebhookClient {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	if !config.allowPrivateHosts {
+		dialer.Control = checkPublicAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	return &WebhookClient{
+		httpClient: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// checkPublicAddress rejects connections to internal addresses, also if the host name of a target (or of a redirect)
+// resolves to one
+func checkPublicAddress(network string, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !types.IsPublicIP(ip) {
+		return fmt.Errorf("webhook target address %s is not public", host)
+	}
+	return nil
+}
+
+// Sign returns the signature of the body sent at timestamp: "sha256=" and the hex encoded HMAC-SHA256 of
+// "<timestamp>.<body>" with the secret of the target
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// RetryDelay is the time to wait after the failed attempt (starting with 1) before the next one
+func RetryDelay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	return retryBaseDelay << (attempt - 1)
+}
+
+// Post sends the JSON body to the URL with the timestamp and signature headers. Any response status other than 2xx
+// is returned as StatusError.
+func (c *WebhookClient) Post(ctx context.Context, url string, secret string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HEADER_TIMESTAMP, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HEADER_SIGNATURE, Sign(secret, timestamp, body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &StatusError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       strings.TrimSpace(string(msg)),
+		}
+	}
+	return nil
+}
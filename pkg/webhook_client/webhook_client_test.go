@@ -0,0 +1,102 @@
+package webhook_client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"type":"test"}' | openssl dgst -sha256 -hmac secret
+	expected := "sha256=5164242d2d7c1061af198b4bfea622c8f5aeec1b9276e38d50a14d7f9dd39bee"
+	if s := Sign("secret", 1700000000, []byte(`{"type":"test"}`)); s != expected {
+		t.Errorf("unexpected signature: %s", s)
+	}
+	if Sign("other", 1700000000, []byte(`{"type":"test"}`)) == expected {
+		t.Error("signature should depend on the secret")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	if d := RetryDelay(1); d != time.Minute {
+		t.Errorf("unexpected delay: %s", d)
+	}
+	if d := RetryDelay(4); d != 8*time.Minute {
+		t.Errorf("unexpected delay: %s", d)
+	}
+}
+
+func TestPost(t *testing.T) {
+	body := []byte(`{"type":"test"}`)
+
+	t.Run("with accepted payload", func(t *testing.T) {
+		var received []byte
+		var timestamp, signature string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, _ = io.ReadAll(r.Body)
+			timestamp = r.Header.Get(HEADER_TIMESTAMP)
+			signature = r.Header.Get(HEADER_SIGNATURE)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := NewWebhookClient(WebhookClientConfig{allowPrivateHosts: true})
+		if err := client.Post(context.Background(), server.URL, "secret", body); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if string(received) != string(body) {
+			t.Errorf("unexpected body: %s", received)
+		}
+		ts, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || signature != Sign("secret", ts, received) {
+			t.Errorf("unexpected signature: %s (timestamp %s)", signature, timestamp)
+		}
+	})
+
+	t.Run("with error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("maintenance"))
+		}))
+		defer server.Close()
+
+		client := NewWebhookClient(WebhookClientConfig{allowPrivateHosts: true})
+		err := client.Post(context.Background(), server.URL, "secret", body)
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable || statusErr.Body != "maintenance" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with private address", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		client := NewWebhookClient(WebhookClientConfig{})
+		err := client.Post(context.Background(), server.URL, "secret", body)
+		if err == nil || called {
+			t.Errorf("request to loopback address should be rejected: %v", err)
+		}
+	})
+
+	t.Run("without response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		client := NewWebhookClient(WebhookClientConfig{allowPrivateHosts: true})
+		err := client.Post(context.Background(), server.URL, "secret", body)
+		var statusErr *StatusError
+		if err == nil || errors.As(err, &statusErr) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}